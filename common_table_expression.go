@@ -0,0 +1,134 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+)
+
+type CommonTableExpression struct {
+	Name                 string
+	SelectQuery          *SelectQuery
+	RecursiveSelectQuery *SelectQuery
+}
+
+func NewCommonTableExpression(name string, selectQuery *SelectQuery) *CommonTableExpression {
+	return &CommonTableExpression{
+		Name:        name,
+		SelectQuery: selectQuery,
+	}
+}
+
+func NewRecursiveCommonTableExpression(name string, anchor, recursive *SelectQuery) *CommonTableExpression {
+	return &CommonTableExpression{
+		Name:                 name,
+		SelectQuery:          anchor,
+		RecursiveSelectQuery: recursive,
+	}
+}
+
+func (c *CommonTableExpression) validate(dialect Dialect) error {
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	if c.Name == "" {
+		return ErrNameIsRequired
+	}
+
+	if c.SelectQuery == nil {
+		return ErrSelectQueryIsRequired
+	}
+
+	return nil
+}
+
+func (c *CommonTableExpression) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		name  string
+		query string
+		err   error
+	)
+
+	err = c.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	name, err = options.alias(dialect, c.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query, args, err = c.SelectQuery.toSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if c.RecursiveSelectQuery != nil {
+		var recursiveQuery string
+
+		recursiveQuery, args, err = c.RecursiveSelectQuery.toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s union all %s", query, recursiveQuery)
+	}
+
+	return fmt.Sprintf("%s as (%s)", name, query), args, nil
+}
+
+func (c *CommonTableExpression) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return c.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func recursiveKeyword(dialect Dialect) string {
+	if recursiveKeywordDialect, ok := dialect.(RecursiveKeywordDialect); ok {
+		return recursiveKeywordDialect.RecursiveKeyword()
+	}
+
+	return "recursive"
+}
+
+func insertCommonTableExpressionPlacement(dialect Dialect) CommonTableExpressionPlacement {
+	if insertCommonTableExpressionDialect, ok := dialect.(InsertCommonTableExpressionDialect); ok {
+		return insertCommonTableExpressionDialect.InsertCommonTableExpressionPlacement()
+	}
+
+	return CommonTableExpressionPlacementStatement
+}
+
+func commonTableExpressionsToSQLWithArgs(dialect Dialect, args []interface{}, commonTableExpressions []*CommonTableExpression, options renderOptions) (string, []interface{}, error) {
+	var (
+		queries   []string
+		recursive bool
+		err       error
+	)
+
+	if len(commonTableExpressions) == 0 {
+		return "", args, nil
+	}
+
+	queries = []string{}
+	for i := range commonTableExpressions {
+		var query string
+
+		if commonTableExpressions[i] == nil {
+			return "", nil, ErrCommonTableExpressionIsNil
+		}
+
+		query, args, err = commonTableExpressions[i].toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		recursive = recursive || commonTableExpressions[i].RecursiveSelectQuery != nil
+		queries = append(queries, query)
+	}
+
+	if recursive && recursiveKeyword(dialect) != "" {
+		return fmt.Sprintf("with %s %s", recursiveKeyword(dialect), strings.Join(queries, ", ")), args, nil
+	}
+
+	return fmt.Sprintf("with %s", strings.Join(queries, ", ")), args, nil
+}
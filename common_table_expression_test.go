@@ -0,0 +1,159 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCommonTableExpression_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name                  string
+		CommonTableExpression *CommonTableExpression
+		Dialect               Dialect
+		Args                  []interface{}
+		Expectation           struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name                  string
+		CommonTableExpression *CommonTableExpression
+		Dialect               Dialect
+		Args                  []interface{}
+		Expectation           struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:                  "dialect is nil",
+			CommonTableExpression: NewCommonTableExpression("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))),
+			Dialect:               nil,
+			Args:                  []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:                  "name is empty",
+			CommonTableExpression: NewCommonTableExpression("", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))),
+			Dialect:               DialectPostgres,
+			Args:                  []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrNameIsRequired,
+			},
+		},
+		{
+			Name:                  "select query is nil",
+			CommonTableExpression: NewCommonTableExpression("cte1", nil),
+			Dialect:               DialectPostgres,
+			Args:                  []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSelectQueryIsRequired,
+			},
+		},
+		{
+			Name:                  "recursive select query to sql with args is error",
+			CommonTableExpression: NewRecursiveCommonTableExpression("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))), &SelectQuery{}),
+			Dialect:               DialectPostgres,
+			Args:                  []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:                  fmt.Sprintf("dialect %s with existing args", DialectPostgres),
+			CommonTableExpression: NewCommonTableExpression("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))),
+			Dialect:               DialectPostgres,
+			Args:                  []interface{}{"value1"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cte1 as (select field1 from table1 where field2 = $2)",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:                  fmt.Sprintf("dialect %s and recursive", DialectMySQL),
+			CommonTableExpression: NewRecursiveCommonTableExpression("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))), Select(NewField("field1")).From(NewTable("cte1")).Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue(10)))),
+			Dialect:               DialectMySQL,
+			Args:                  []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cte1 as (select field1 from table1 where field2 = ? union all select field1 from cte1 where field1 < ?)",
+				Args:  []interface{}{"value2", 10},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].CommonTableExpression.ToSQLWithArgs(testCases[i].Dialect, testCases[i].Args)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package simple_query
+
+import "fmt"
+
+type CompoundQuery struct {
+	Operator    SetOperator
+	SelectQuery *SelectQuery
+}
+
+func NewCompoundQuery(operator SetOperator, selectQuery *SelectQuery) *CompoundQuery {
+	return &CompoundQuery{
+		Operator:    operator,
+		SelectQuery: selectQuery,
+	}
+}
+
+func Union(selectQueries ...*SelectQuery) *SelectQuery {
+	return compound(SetOperatorUnion, selectQueries)
+}
+
+func UnionAll(selectQueries ...*SelectQuery) *SelectQuery {
+	return compound(SetOperatorUnionAll, selectQueries)
+}
+
+func Intersect(selectQueries ...*SelectQuery) *SelectQuery {
+	return compound(SetOperatorIntersect, selectQueries)
+}
+
+func Except(selectQueries ...*SelectQuery) *SelectQuery {
+	return compound(SetOperatorExcept, selectQueries)
+}
+
+func compound(operator SetOperator, selectQueries []*SelectQuery) *SelectQuery {
+	var selectQuery *SelectQuery = &SelectQuery{
+		Compounds: []*CompoundQuery{},
+	}
+
+	for i := range selectQueries {
+		selectQuery.Compounds = append(selectQuery.Compounds, NewCompoundQuery(operator, selectQueries[i]))
+	}
+
+	return selectQuery
+}
+
+func (c *CompoundQuery) validate(dialect Dialect, first bool) error {
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	if c.SelectQuery == nil {
+		return ErrSelectQueryIsRequired
+	}
+
+	if first {
+		return nil
+	}
+
+	if c.Operator == "" {
+		return ErrOperatorIsRequired
+	}
+
+	if _, ok := setOperatorMap[c.Operator]; !ok {
+		return fmt.Errorf(errUnsupportedSetOperatorf, c.Operator)
+	}
+
+	return nil
+}
+
+func (c *CompoundQuery) toSQLWithArgs(dialect Dialect, args []interface{}, first bool, options renderOptions) (string, []interface{}, error) {
+	var (
+		query string
+		err   error
+	)
+
+	err = c.validate(dialect, first)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = c.SelectQuery.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query, args, err = c.SelectQuery.queryToSQLWithArgs(dialect, args, options.merge(c.SelectQuery.QuotedIdentifiers, c.SelectQuery.Schema))
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(c.SelectQuery.Sorts) > 0 || c.SelectQuery.Take > 0 || c.SelectQuery.Skip > 0 || len(c.SelectQuery.Compounds) > 0 {
+		query = fmt.Sprintf("select * from (%s) as %s", query, options.quote(dialect, "compound_query"))
+	}
+
+	if first {
+		return query, args, nil
+	}
+
+	return fmt.Sprintf("%s %s", setOperatorMap[c.Operator], query), args, nil
+}
@@ -0,0 +1,126 @@
+package simple_query
+
+import "testing"
+
+func TestCompoundQuery_NewCompoundQuery(t *testing.T) {
+	var (
+		selectQuery *SelectQuery
+		expectation *CompoundQuery
+		actual      *CompoundQuery
+	)
+
+	selectQuery = Select(NewField("field1")).From(NewTable("table1"))
+	expectation = &CompoundQuery{
+		Operator:    SetOperatorUnion,
+		SelectQuery: selectQuery,
+	}
+	actual = NewCompoundQuery(SetOperatorUnion, selectQuery)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation compound query is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestCompoundQuery_compound(t *testing.T) {
+	var (
+		selectQuery1 *SelectQuery
+		selectQuery2 *SelectQuery
+		testCases    []struct {
+			Name        string
+			SelectQuery *SelectQuery
+			Expectation *SelectQuery
+		}
+	)
+
+	selectQuery1 = Select(NewField("field1")).From(NewTable("table1"))
+	selectQuery2 = Select(NewField("field1")).From(NewTable("table2"))
+
+	testCases = []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Expectation *SelectQuery
+	}{
+		{
+			Name:        "union",
+			SelectQuery: Union(selectQuery1, selectQuery2),
+			Expectation: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery(SetOperatorUnion, selectQuery1),
+					NewCompoundQuery(SetOperatorUnion, selectQuery2),
+				},
+			},
+		},
+		{
+			Name:        "union all",
+			SelectQuery: UnionAll(selectQuery1, selectQuery2),
+			Expectation: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery(SetOperatorUnionAll, selectQuery1),
+					NewCompoundQuery(SetOperatorUnionAll, selectQuery2),
+				},
+			},
+		},
+		{
+			Name:        "intersect",
+			SelectQuery: Intersect(selectQuery1, selectQuery2),
+			Expectation: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery(SetOperatorIntersect, selectQuery1),
+					NewCompoundQuery(SetOperatorIntersect, selectQuery2),
+				},
+			},
+		},
+		{
+			Name:        "except",
+			SelectQuery: Except(selectQuery1, selectQuery2),
+			Expectation: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery(SetOperatorExcept, selectQuery1),
+					NewCompoundQuery(SetOperatorExcept, selectQuery2),
+				},
+			},
+		},
+		{
+			Name: "select query union with common table expression and alias",
+			SelectQuery: Select(NewField("field1")).
+				With("cte1", selectQuery2).
+				From(NewTable("cte1")).
+				As("alias1").
+				Union(selectQuery1),
+			Expectation: &SelectQuery{
+				CommonTableExpressions: []*CommonTableExpression{NewCommonTableExpression("cte1", selectQuery2)},
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery("", Select(NewField("field1")).From(NewTable("cte1"))),
+					NewCompoundQuery(SetOperatorUnion, selectQuery1),
+				},
+				Alias: "alias1",
+			},
+		},
+		{
+			Name: "select query union with sorts, take and skip",
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table3")).
+				OrderBy(NewSort("field1", SortDirectionAscending)).
+				Limit(10).
+				Offset(20).
+				Union(selectQuery1),
+			Expectation: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery("", Select(NewField("field1")).From(NewTable("table3"))),
+					NewCompoundQuery(SetOperatorUnion, selectQuery1),
+				},
+				Sorts: []*Sort{NewSort("field1", SortDirectionAscending)},
+				Take:  10,
+				Skip:  20,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if !deepEqual(testCases[i].Expectation, testCases[i].SelectQuery) {
+				t.Errorf("expectation select query is %+v, got %+v", testCases[i].Expectation, testCases[i].SelectQuery)
+			}
+		})
+	}
+}
@@ -2,54 +2,176 @@ package simple_query
 
 import "errors"
 
-type Dialect string
+type Logic string
+type Operator string
 
 const (
-	DialectMySQL    Dialect = "mysql"
-	DialectPostgres Dialect = "postgres"
+	LogicAnd Logic = "and"
+	LogicOr  Logic = "or"
+
+	OperatorEqual                  Operator = "equal"
+	OperatorNotEqual               Operator = "not_equal"
+	OperatorGreaterThan            Operator = "greater_than"
+	OperatorGreaterThanOrEqual     Operator = "greater_than_or_equal"
+	OperatorLessThan               Operator = "less_than"
+	OperatorLessThanOrEqual        Operator = "less_than_or_equal"
+	OperatorIsNull                 Operator = "is_null"
+	OperatorIsNotNull              Operator = "is_not_null"
+	OperatorIn                     Operator = "in"
+	OperatorNotIn                  Operator = "not_in"
+	OperatorLike                   Operator = "like"
+	OperatorNotLike                Operator = "not_like"
+	OperatorBetween                Operator = "between"
+	OperatorNotBetween             Operator = "not_between"
+	OperatorExists                 Operator = "exists"
+	OperatorNotExists              Operator = "not_exists"
+	OperatorStartsWith             Operator = "starts_with"
+	OperatorEndsWith               Operator = "ends_with"
+	OperatorContains               Operator = "contains"
+	OperatorLikePattern            Operator = "like_pattern"
+	OperatorLikeCaseSensitive      Operator = "like_case_sensitive"
+	OperatorNotLikeCaseSensitive   Operator = "not_like_case_sensitive"
+	OperatorLikeCaseInsensitive    Operator = "like_case_insensitive"
+	OperatorNotLikeCaseInsensitive Operator = "not_like_case_insensitive"
 )
 
-var placeholderMap map[Dialect]string = map[Dialect]string{
-	DialectMySQL:    "?",
-	DialectPostgres: "$",
+var filterOperatorMap map[Operator]string = map[Operator]string{
+	OperatorEqual:                  "=",
+	OperatorNotEqual:               "!=",
+	OperatorGreaterThan:            ">",
+	OperatorGreaterThanOrEqual:     ">=",
+	OperatorLessThan:               "<",
+	OperatorLessThanOrEqual:        "<=",
+	OperatorIsNull:                 "is null",
+	OperatorIsNotNull:              "is not null",
+	OperatorIn:                     "in",
+	OperatorNotIn:                  "not in",
+	OperatorLike:                   "like",
+	OperatorNotLike:                "not like",
+	OperatorBetween:                "between",
+	OperatorNotBetween:             "not between",
+	OperatorExists:                 "exists",
+	OperatorNotExists:              "not exists",
+	OperatorStartsWith:             "like",
+	OperatorEndsWith:               "like",
+	OperatorContains:               "like",
+	OperatorLikePattern:            "like",
+	OperatorLikeCaseSensitive:      "like",
+	OperatorNotLikeCaseSensitive:   "not like",
+	OperatorLikeCaseInsensitive:    "like",
+	OperatorNotLikeCaseInsensitive: "not like",
 }
 
-type Logic string
-type Operator string
+type CaseSensitivity string
 
 const (
-	LogicAnd Logic = "and"
-	LogicOr  Logic = "or"
+	CaseSensitivitySensitive   CaseSensitivity = "sensitive"
+	CaseSensitivityInsensitive CaseSensitivity = "insensitive"
+)
+
+type ArithmeticOperator string
+
+const (
+	ArithmeticOperatorAdd      ArithmeticOperator = "add"
+	ArithmeticOperatorSubtract ArithmeticOperator = "subtract"
+	ArithmeticOperatorMultiply ArithmeticOperator = "multiply"
+	ArithmeticOperatorDivide   ArithmeticOperator = "divide"
+	ArithmeticOperatorModulo   ArithmeticOperator = "modulo"
+)
+
+var arithmeticOperatorMap map[ArithmeticOperator]string = map[ArithmeticOperator]string{
+	ArithmeticOperatorAdd:      "+",
+	ArithmeticOperatorSubtract: "-",
+	ArithmeticOperatorMultiply: "*",
+	ArithmeticOperatorDivide:   "/",
+	ArithmeticOperatorModulo:   "%",
+}
 
-	OperatorEqual              Operator = "equal"
-	OperatorNotEqual           Operator = "not_equal"
-	OperatorGreaterThan        Operator = "greater_than"
-	OperatorGreaterThanOrEqual Operator = "greater_than_or_equal"
-	OperatorLessThan           Operator = "less_than"
-	OperatorLessThanOrEqual    Operator = "less_than_or_equal"
-	OperatorIsNull             Operator = "is_null"
-	OperatorIsNotNull          Operator = "is_not_null"
-	OperatorIn                 Operator = "in"
-	OperatorNotIn              Operator = "not_in"
-	OperatorLike               Operator = "like"
-	OperatorNotLike            Operator = "not_like"
+type JoinType string
+
+const (
+	JoinTypeInner JoinType = "inner"
+	JoinTypeLeft  JoinType = "left"
+	JoinTypeRight JoinType = "right"
+	JoinTypeFull  JoinType = "full"
+	JoinTypeCross JoinType = "cross"
 )
 
-var filterOperatorMap map[Operator]string = map[Operator]string{
-	OperatorEqual:              "=",
-	OperatorNotEqual:           "!=",
-	OperatorGreaterThan:        ">",
-	OperatorGreaterThanOrEqual: ">=",
-	OperatorLessThan:           "<",
-	OperatorLessThanOrEqual:    "<=",
-	OperatorIsNull:             "is null",
-	OperatorIsNotNull:          "is not null",
-	OperatorIn:                 "in",
-	OperatorNotIn:              "not in",
-	OperatorLike:               "like",
-	OperatorNotLike:            "not like",
+var joinTypeMap map[JoinType]string = map[JoinType]string{
+	JoinTypeInner: "inner join",
+	JoinTypeLeft:  "left join",
+	JoinTypeRight: "right join",
+	JoinTypeFull:  "full join",
+	JoinTypeCross: "cross join",
+}
+
+var aggregateFunctionMap map[string]bool = map[string]bool{
+	"count": true,
+	"sum":   true,
+	"avg":   true,
+	"min":   true,
+	"max":   true,
+}
+
+type SetOperator string
+
+const (
+	SetOperatorUnion     SetOperator = "union"
+	SetOperatorUnionAll  SetOperator = "union_all"
+	SetOperatorIntersect SetOperator = "intersect"
+	SetOperatorExcept    SetOperator = "except"
+)
+
+var setOperatorMap map[SetOperator]string = map[SetOperator]string{
+	SetOperatorUnion:     "union",
+	SetOperatorUnionAll:  "union all",
+	SetOperatorIntersect: "intersect",
+	SetOperatorExcept:    "except",
 }
 
+type ConflictAction string
+
+const (
+	ConflictActionDoNothing ConflictAction = "do_nothing"
+	ConflictActionDoUpdate  ConflictAction = "do_update"
+)
+
+type UpdateJoinStyle string
+
+const (
+	UpdateJoinStyleJoin       UpdateJoinStyle = "join"
+	UpdateJoinStyleFrom       UpdateJoinStyle = "from"
+	UpdateJoinStyleFromTarget UpdateJoinStyle = "from_target"
+)
+
+type DeleteJoinStyle string
+
+const (
+	DeleteJoinStyleFromTarget DeleteJoinStyle = "from_target"
+	DeleteJoinStyleUsing      DeleteJoinStyle = "using"
+)
+
+type CommonTableExpressionPlacement string
+
+const (
+	CommonTableExpressionPlacementStatement CommonTableExpressionPlacement = "statement"
+	CommonTableExpressionPlacementSelect    CommonTableExpressionPlacement = "select"
+)
+
+type OrderByLimitStyle string
+
+const (
+	OrderByLimitStyleClause OrderByLimitStyle = "clause"
+	OrderByLimitStyleCTID   OrderByLimitStyle = "ctid"
+)
+
+type UpsertStyle string
+
+const (
+	UpsertStyleOnConflict     UpsertStyle = "on_conflict"
+	UpsertStyleOnDuplicateKey UpsertStyle = "on_duplicate_key"
+)
+
 type SortDirection string
 
 const (
@@ -58,31 +180,71 @@ const (
 )
 
 const (
-	errForOperatorf                     string = "%s for operator %s"
-	errUnsupportedValueTypeForOperatorf string = "unsupported %s value type for operator %s"
-	errUnsupportedValueTypef            string = "unsupported %s value type"
+	errForOperatorf                                string = "%s for operator %s"
+	errUnsupportedValueTypeForOperatorf            string = "unsupported %s value type for operator %s"
+	errUnsupportedValueTypef                       string = "unsupported %s value type"
+	errUnsupportedArithmeticOperatorf              string = "unsupported arithmetic operator %s"
+	errUnsupportedCaseSensitivityf                 string = "unsupported case sensitivity %s"
+	errUnsupportedCaseSensitivityForDialectf       string = "unsupported case sensitivity %s for dialect %s"
+	errUnsupportedSetOperatorf                     string = "unsupported set operator %s"
+	errUnsupportedSortDirectionf                   string = "unsupported sort direction %s"
+	errUnsupportedJoinForDialectf                  string = "unsupported join for dialect %s"
+	errUnsupportedJoinTypef                        string = "unsupported join type %s"
+	errUnsupportedJoinTypeForDialectf              string = "unsupported join type %s for dialect %s"
+	errUnsupportedDialectf                         string = "unsupported dialect %s"
+	errUnsupportedReturningForDialectf             string = "unsupported returning for dialect %s"
+	errUnsupportedOrderByLimitForDialectf          string = "unsupported order by or limit for dialect %s"
+	errUnsupportedCommonTableExpressionForDialectf string = "unsupported common table expression for dialect %s"
+	errUnsupportedConflictActionf                  string = "unsupported conflict action %s"
+	errUnsupportedOnConflictForDialectf            string = "unsupported on conflict for dialect %s"
+	errUnsupportedOnConflictFilterForDialectf      string = "unsupported on conflict filter for dialect %s"
 )
 
 var (
-	ErrAliasIsRequired                        error = errors.New("alias is required")
-	ErrColumnIsRequired                       error = errors.New("column is required")
-	ErrConflictFieldColumnAndFieldSelectQuery error = errors.New("conflict between field column and field select query")
-	ErrConflictTableNameAndTableSelectQuery   error = errors.New("conflict between table name and table select query")
-	ErrDialectIsRequired                      error = errors.New("dialect is required")
-	ErrFieldIsNil                             error = errors.New("field is nil")
-	ErrFieldIsNotEmpty                        error = errors.New("field is not empty")
-	ErrFieldIsRequired                        error = errors.New("field is required")
-	ErrFieldsIsRequired                       error = errors.New("fields is required")
-	ErrFilterIsRequired                       error = errors.New("filter is required")
-	ErrFilterValueIsNil                       error = errors.New("filter value is nil")
-	ErrFiltersIsRequired                      error = errors.New("filters is required")
-	ErrLogicIsRequired                        error = errors.New("logic is required")
-	ErrNameIsRequired                         error = errors.New("name is required")
-	ErrOperatorIsNotEmpty                     error = errors.New("operator is not empty")
-	ErrOperatorIsRequired                     error = errors.New("operator is required")
-	ErrTableIsRequired                        error = errors.New("table is required")
-	ErrValueIsNotNil                          error = errors.New("value is not nil")
-	ErrValueIsRequired                        error = errors.New("value is required")
-	ErrValueLengthIsNotEqualToFieldsLength    error = errors.New("value length is not equal to fields length")
-	ErrValuesIsRequired                       error = errors.New("values is required")
+	ErrAliasIsRequired                                   error = errors.New("alias is required")
+	ErrArgsIsRequired                                    error = errors.New("args is required")
+	ErrBatchLimitIsTooSmall                              error = errors.New("batch limit is too small")
+	ErrColumnIsRequired                                  error = errors.New("column is required")
+	ErrCommonTableExpressionIsNil                        error = errors.New("common table expression is nil")
+	ErrConflictActionIsRequired                          error = errors.New("conflict action is required")
+	ErrConflictColumnsIsRequired                         error = errors.New("conflict columns is required")
+	ErrConflictCompoundsAndSelectClauses                 error = errors.New("conflict between compounds and select clauses")
+	ErrConflictFieldColumnAndFieldFunction               error = errors.New("conflict between field column and field function")
+	ErrConflictFieldColumnAndFieldSelectQuery            error = errors.New("conflict between field column and field select query")
+	ErrConflictFieldSelectQueryAndFieldFunction          error = errors.New("conflict between field select query and field function")
+	ErrConflictFilterValueFieldAndFilterValueSelectQuery error = errors.New("conflict between filter value field and filter value select query")
+	ErrConflictFilterValueFieldAndFilterValueValue       error = errors.New("conflict between filter value field and filter value value")
+	ErrConflictInsertValuesAndInsertSelectQuery          error = errors.New("conflict between insert values and insert select query")
+	ErrConflictJoinsAndOrderByOrLimit                    error = errors.New("conflict between joins and order by or limit")
+	ErrConflictSortFieldAndSortExpression                error = errors.New("conflict between sort field and sort expression")
+	ErrConflictTableNameAndTableSelectQuery              error = errors.New("conflict between table name and table select query")
+	ErrDialectIsRequired                                 error = errors.New("dialect is required")
+	ErrFieldIsNil                                        error = errors.New("field is nil")
+	ErrFieldIsNotEmpty                                   error = errors.New("field is not empty")
+	ErrFieldIsRequired                                   error = errors.New("field is required")
+	ErrFieldsIsRequired                                  error = errors.New("fields is required")
+	ErrFilterIsNotNil                                    error = errors.New("filter is not nil")
+	ErrFilterIsRequired                                  error = errors.New("filter is required")
+	ErrFilterValueIsNil                                  error = errors.New("filter value is nil")
+	ErrFiltersIsRequired                                 error = errors.New("filters is required")
+	ErrGroupByIsRequired                                 error = errors.New("group by is required")
+	ErrIdentifierIsInvalid                               error = errors.New("identifier is invalid")
+	ErrIdentifierIsNotAllowed                            error = errors.New("identifier is not allowed")
+	ErrJoinIsNil                                         error = errors.New("join is nil")
+	ErrJoinTypeIsRequired                                error = errors.New("join type is required")
+	ErrLimitIsRequired                                   error = errors.New("limit is required")
+	ErrLogicIsRequired                                   error = errors.New("logic is required")
+	ErrNameIsRequired                                    error = errors.New("name is required")
+	ErrOffsetIsOverflow                                  error = errors.New("offset is overflow")
+	ErrOperatorIsNotEmpty                                error = errors.New("operator is not empty")
+	ErrOperatorIsRequired                                error = errors.New("operator is required")
+	ErrRowColumnsIsNotEqualToFieldsColumns               error = errors.New("row columns is not equal to fields columns")
+	ErrSelectFieldsLengthIsNotEqualToFieldsLength        error = errors.New("select fields length is not equal to fields length")
+	ErrSelectQueryIsRequired                             error = errors.New("select query is required")
+	ErrTableIsRequired                                   error = errors.New("table is required")
+	ErrValueIsNotNil                                     error = errors.New("value is not nil")
+	ErrValueIsRequired                                   error = errors.New("value is required")
+	ErrValueLengthIsNotEqualToTwo                        error = errors.New("value length is not equal to two")
+	ErrValueLengthIsNotEqualToFieldsLength               error = errors.New("value length is not equal to fields length")
+	ErrValuesIsRequired                                  error = errors.New("values is required")
 )
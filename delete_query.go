@@ -5,26 +5,84 @@ import (
 )
 
 type DeleteQuery struct {
-	Table  string
-	Filter *Filter
+	CommonTableExpressions []*CommonTableExpression
+	Table                  string
+	Joins                  []*Join
+	Filter                 *Filter
+	Sorts                  []*Sort
+	Take                   uint64
+	ReturningFields        []*Field
+
+	QuotedIdentifiers bool
+	Schema            *Schema
 }
 
 func Delete() *DeleteQuery {
 	return &DeleteQuery{}
 }
 
+func (d *DeleteQuery) With(name string, selectQuery *SelectQuery) *DeleteQuery {
+	d.CommonTableExpressions = append(d.CommonTableExpressions, NewCommonTableExpression(name, selectQuery))
+	return d
+}
+
+func (d *DeleteQuery) WithRecursive(name string, anchor, recursive *SelectQuery) *DeleteQuery {
+	d.CommonTableExpressions = append(d.CommonTableExpressions, NewRecursiveCommonTableExpression(name, anchor, recursive))
+	return d
+}
+
 func (d *DeleteQuery) From(table string) *DeleteQuery {
 	d.Table = table
 	return d
 }
 
+func (d *DeleteQuery) Join(table *Table, filter *Filter) *DeleteQuery {
+	d.Joins = append(d.Joins, NewJoin(JoinTypeInner, table, filter))
+	return d
+}
+
+func (d *DeleteQuery) LeftJoin(table *Table, filter *Filter) *DeleteQuery {
+	d.Joins = append(d.Joins, NewJoin(JoinTypeLeft, table, filter))
+	return d
+}
+
 func (d *DeleteQuery) Where(filter *Filter) *DeleteQuery {
 	d.Filter = filter
 	return d
 }
 
+func (d *DeleteQuery) OrderBy(sorts ...*Sort) *DeleteQuery {
+	d.Sorts = sorts
+	return d
+}
+
+func (d *DeleteQuery) Limit(take uint64) *DeleteQuery {
+	d.Take = take
+	return d
+}
+
+func (d *DeleteQuery) Returning(fields ...*Field) *DeleteQuery {
+	d.ReturningFields = fields
+	return d
+}
+
+func (d *DeleteQuery) QuoteIdentifiers() *DeleteQuery {
+	d.QuotedIdentifiers = true
+	return d
+}
+
+func (d *DeleteQuery) WithSchema(schema *Schema) *DeleteQuery {
+	d.Schema = schema
+	return d
+}
+
 func (d *DeleteQuery) validate(dialect Dialect) error {
-	if dialect == "" {
+	var (
+		joinStyle DeleteJoinStyle
+		err       error
+	)
+
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
@@ -36,14 +94,36 @@ func (d *DeleteQuery) validate(dialect Dialect) error {
 		return ErrFilterIsRequired
 	}
 
-	return nil
+	joinStyle = deleteJoinStyle(dialect)
+	if len(d.Joins) > 0 && joinStyle != DeleteJoinStyleFromTarget && joinStyle != DeleteJoinStyleUsing {
+		return fmt.Errorf(errUnsupportedJoinForDialectf, dialect.Name())
+	}
+
+	for i := range d.Joins {
+		if d.Joins[i] == nil {
+			return ErrJoinIsNil
+		}
+	}
+
+	err = validateOrderByLimit(dialect, d.Joins, d.Sorts, d.Take)
+	if err != nil {
+		return err
+	}
+
+	return validateReturning(dialect, d.ReturningFields)
 }
 
 func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
 	var (
 		query       string
 		args        []interface{}
+		withClause  string
+		joinClause  string
+		filter      *Filter
 		whereClause string
+		table       string
+		joinStyle   DeleteJoinStyle
+		options     renderOptions
 		err         error
 	)
 
@@ -52,11 +132,53 @@ func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("delete from %s", d.Table)
+	options = renderOptions{quoteIdentifiers: d.QuotedIdentifiers, schema: d.Schema}
+	table, err = options.identifier(dialect, d.Table)
+	if err != nil {
+		return "", nil, err
+	}
+
 	args = []interface{}{}
+	withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, d.CommonTableExpressions, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("delete from %s", table)
+	filter = d.Filter
+	joinStyle = deleteJoinStyle(dialect)
+
+	if len(d.Joins) > 0 && joinStyle == DeleteJoinStyleFromTarget {
+		joinClause, args, err = joinsToSQLWithArgs(dialect, args, d.Joins, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("delete %s from %s %s", table, table, joinClause)
+	}
+
+	if withClause != "" {
+		query = fmt.Sprintf("%s %s", withClause, query)
+	}
 
-	if d.Filter != nil {
-		whereClause, args, err = d.Filter.ToSQLWithArgs(dialect, args)
+	if len(d.Joins) > 0 && joinStyle == DeleteJoinStyleUsing {
+		var joinFilter *Filter
+
+		joinClause, joinFilter, args, err = joinsToFromSQLWithArgs(dialect, args, d.Joins, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s using %s", query, joinClause)
+		filter = combineFilters(joinFilter, d.Filter)
+	}
+
+	if (len(d.Sorts) > 0 || d.Take > 0) && orderByLimitStyle(dialect) == OrderByLimitStyleCTID {
+		filter = orderByLimitFilter(d.Table, filter, d.Sorts, d.Take)
+	}
+
+	if filter != nil {
+		whereClause, args, err = filter.toSQLWithArgsWithOptions(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -66,5 +188,29 @@ func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		}
 	}
 
+	if (len(d.Sorts) > 0 || d.Take > 0) && orderByLimitStyle(dialect) == OrderByLimitStyleClause {
+		var orderByLimitClause string
+
+		orderByLimitClause, args, err = orderByLimitToSQLWithArgs(dialect, args, d.Sorts, d.Take, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if orderByLimitClause != "" {
+			query = fmt.Sprintf("%s %s", query, orderByLimitClause)
+		}
+	}
+
+	if len(d.ReturningFields) > 0 {
+		var returningClause string
+
+		returningClause, args, err = returningToSQLWithArgs(dialect, args, d.ReturningFields, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s %s", query, returningClause)
+	}
+
 	return query, args, nil
 }
@@ -89,6 +89,26 @@ func TestDeleteQuery_Where(t *testing.T) {
 	testDeleteQuery_DeleteQueryEquality(t, expectation, actual)
 }
 
+func TestDeleteQuery_QuoteIdentifiers(t *testing.T) {
+	var actual *DeleteQuery = Delete().
+		From("table1").
+		QuoteIdentifiers()
+
+	if !actual.QuotedIdentifiers {
+		t.Error("expectation quoted identifiers is true, got false")
+	}
+}
+
+func TestDeleteQuery_WithSchema(t *testing.T) {
+	var actual *DeleteQuery = Delete().
+		From("table1").
+		WithSchema(NewSchema("table1"))
+
+	if actual.Schema == nil {
+		t.Error("expectation schema is not nil, got nil")
+	}
+}
+
 func TestDeleteQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -103,7 +123,7 @@ func TestDeleteQuery_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialeg is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			DeleteQuery: &DeleteQuery{},
 			Expectation: ErrDialectIsRequired,
 		},
@@ -198,6 +218,292 @@ func TestDeleteQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   ErrTableIsRequired,
 			},
 		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and returning", DialectPostgres),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))).
+				Returning(NewField("id"), NewField("field2")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where field1 = $1 returning id, field2",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and returning", DialectMySQL),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))).
+				Returning(NewField("id")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedReturningForDialectf, DialectMySQL.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and joins", DialectMySQL),
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete table1 from table1 inner join table2 as t2 on t2.field2 = ? left join table3 as t3 on t3.field3 = ? where table1.field4 = ?",
+				Args:  []interface{}{"value2", "value3", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and joins", DialectPostgres),
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 using table2 as t2 left join table3 as t3 on t3.field3 = $1 where t2.field2 = $2 and table1.field4 = $3",
+				Args:  []interface{}{"value3", "value2", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and joins", DialectSQLServer),
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete table1 from table1 inner join table2 as t2 on t2.field2 = @p1 left join table3 as t3 on t3.field3 = @p2 where table1.field4 = @p3",
+				Args:  []interface{}{"value2", "value3", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and joins", DialectSQLite),
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedJoinForDialectf, DialectSQLite.Name()),
+			},
+		},
+		{
+			Name: "delete query with custom dialect mariadb and joins",
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				Where(NewFilter().SetCondition(NewField("table1.field3"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect: mariaDBDialect{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete table1 from table1 inner join table2 as t2 on t2.field2 = ? where table1.field3 = ?",
+				Args:  []interface{}{"value2", "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and join is nil", DialectMySQL),
+			DeleteQuery: &DeleteQuery{
+				Table:  "table1",
+				Joins:  []*Join{nil},
+				Filter: NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1")),
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinIsNil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and order by and limit", DialectMySQL),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue("value1"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where field1 < ? order by id asc limit ?",
+				Args:  []interface{}{"value1", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "delete query with custom dialect mariadb and order by and limit",
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue("value1"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: mariaDBDialect{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where field1 < ? order by id asc limit ?",
+				Args:  []interface{}{"value1", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and order by and limit", DialectPostgres),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue("value1"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000).
+				Returning(NewField("id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where ctid in (select ctid from table1 where field1 < $1 order by id asc limit $2) returning id",
+				Args:  []interface{}{"value1", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s, schema and order by and limit", DialectPostgres),
+			DeleteQuery: Delete().
+				From("t").
+				Where(NewFilter().SetCondition(NewField("x"), OperatorLessThan, NewFilterValue("value1"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(10).
+				WithSchema(NewSchema("t", "x", "id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from t where ctid in (select ctid from t where x < $1 order by id asc limit $2)",
+				Args:  []interface{}{"value1", uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s, schema and user ctid filter", DialectPostgres),
+			DeleteQuery: Delete().
+				From("t").
+				Where(NewFilter().SetCondition(NewField("ctid"), OperatorEqual, NewFilterValue("value1"))).
+				WithSchema(NewSchema("t", "x", "id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "ctid", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s, quoted identifiers and limit", DialectPostgres),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue("value1"))).
+				Limit(1000).
+				QuoteIdentifiers(),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from \"table1\" where \"ctid\" in (select \"ctid\" from \"table1\" where \"field1\" < $1 limit $2)",
+				Args:  []interface{}{"value1", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and limit", DialectSQLite),
+			DeleteQuery: Delete().
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorLessThan, NewFilterValue("value1"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedOrderByLimitForDialectf, DialectSQLite.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s, joins and limit", DialectMySQL),
+			DeleteQuery: Delete().
+				From("table1").
+				Join(NewTable("table2"), NewFilter().SetCondition(NewField("table2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				Where(NewFilter().SetCondition(NewField("table1.field1"), OperatorEqual, NewFilterValue("value1"))).
+				Limit(1000),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictJoinsAndOrderByOrLimit,
+			},
+		},
 		{
 			Name: fmt.Sprintf("delete query with dialect %s and filter to sql args is error", DialectPostgres),
 			DeleteQuery: &DeleteQuery{
@@ -245,6 +551,144 @@ func TestDeleteQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and quoted identifiers", DialectMySQL),
+			DeleteQuery: &DeleteQuery{
+				Table: "order",
+				Filter: &Filter{
+					Field: &Field{
+						Column: "key",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from `order` where `key` = ?",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and schema and table is not allowed", DialectPostgres),
+			DeleteQuery: &DeleteQuery{
+				Table: "table2",
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "table2", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s", DialectSQLite),
+			DeleteQuery: &DeleteQuery{
+				Table: "table1",
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorIn,
+					Value: &FilterValue{
+						Value: []int{1, 2},
+					},
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `delete from "table1" where "field1" in (?, ?)`,
+				Args:  []interface{}{1, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s", DialectSQLServer),
+			DeleteQuery: &DeleteQuery{
+				Table: "table1",
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorIn,
+					Value: &FilterValue{
+						Value: []int{1, 2},
+					},
+				},
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where field1 in (@p1, @p2)",
+				Args:  []interface{}{1, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s and common table expression", DialectSQLServer),
+			DeleteQuery: Delete().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				From("table1").
+				Where(NewFilter().SetCondition(NewField("field3"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("cte1"))))),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = @p1) delete from table1 where field3 in (select field1 from cte1)",
+				Args:  []interface{}{"value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("delete query with dialect %s, common table expression and joins", DialectMySQL),
+			DeleteQuery: Delete().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				From("table1").
+				Join(NewTable("cte1"), NewFilter().SetCondition(NewField("cte1.field1"), OperatorEqual, NewFilterValue("value1"))).
+				Where(NewFilter().SetCondition(NewField("table1.field3"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = ?) delete table1 from table1 inner join cte1 on cte1.field1 = ? where table1.field3 = ?",
+				Args:  []interface{}{"value2", "value1", "value3"},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
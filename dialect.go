@@ -0,0 +1,487 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type Dialect interface {
+	Name() string
+	Placeholder(index int) string
+	QuoteIdentifier(identifier string) string
+	Like(field, pattern string, negate bool) string
+	Concat(values ...string) string
+	LimitOffset(limit, offset uint64, hasOrderBy bool, args []interface{}) (string, []interface{}, error)
+}
+
+type BoolLiteralDialect interface {
+	BoolLiteral(value bool) string
+}
+
+type ReturningDialect interface {
+	SupportsReturning() bool
+}
+
+type RecursiveKeywordDialect interface {
+	RecursiveKeyword() string
+}
+
+type InsertCommonTableExpressionDialect interface {
+	InsertCommonTableExpressionPlacement() CommonTableExpressionPlacement
+}
+
+type JoinTypeDialect interface {
+	SupportsJoinType(joinType JoinType) bool
+}
+
+type UpdateJoinDialect interface {
+	UpdateJoinStyle() UpdateJoinStyle
+}
+
+type DeleteJoinDialect interface {
+	DeleteJoinStyle() DeleteJoinStyle
+}
+
+type OrderByLimitDialect interface {
+	OrderByLimitStyle() OrderByLimitStyle
+}
+
+type UpsertDialect interface {
+	UpsertStyle() UpsertStyle
+}
+
+type LikeCaseDialect interface {
+	LikeCase(field, pattern string, negate, caseSensitive bool) (string, error)
+}
+
+type LikeEscapeDialect interface {
+	LikeEscape() string
+}
+
+type BatchLimit struct {
+	MaxParameters int
+	MaxRows       int
+}
+
+type BatchLimitDialect interface {
+	BatchLimit() BatchLimit
+}
+
+var (
+	DialectMySQL     Dialect = mySQLDialect{}
+	DialectPostgres  Dialect = postgresDialect{}
+	DialectSQLite    Dialect = sqliteDialect{}
+	DialectSQLServer Dialect = sqlServerDialect{}
+)
+
+var (
+	dialectsMutex sync.RWMutex
+	dialects      map[string]Dialect = map[string]Dialect{
+		DialectMySQL.Name():     DialectMySQL,
+		DialectPostgres.Name():  DialectPostgres,
+		DialectSQLite.Name():    DialectSQLite,
+		DialectSQLServer.Name(): DialectSQLServer,
+	}
+)
+
+func RegisterDialect(dialect Dialect) error {
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	if dialect.Name() == "" {
+		return ErrNameIsRequired
+	}
+
+	dialectsMutex.Lock()
+	defer dialectsMutex.Unlock()
+
+	dialects[dialect.Name()] = dialect
+
+	return nil
+}
+
+func GetDialect(name string) (Dialect, error) {
+	var (
+		dialect Dialect
+		ok      bool
+	)
+
+	dialectsMutex.RLock()
+	defer dialectsMutex.RUnlock()
+
+	dialect, ok = dialects[name]
+	if !ok {
+		return nil, fmt.Errorf(errUnsupportedDialectf, name)
+	}
+
+	return dialect, nil
+}
+
+func limitOffset(dialect Dialect, limit, offset uint64, args []interface{}) (string, []interface{}) {
+	var clauses []string = []string{}
+
+	if limit > 0 {
+		args = append(args, limit)
+		clauses = append(clauses, fmt.Sprintf("limit %s", dialect.Placeholder(len(args))))
+	}
+
+	if offset > 0 {
+		args = append(args, offset)
+		clauses = append(clauses, fmt.Sprintf("offset %s", dialect.Placeholder(len(args))))
+	}
+
+	return strings.Join(clauses, " "), args
+}
+
+type mySQLDialect struct{}
+
+func (mySQLDialect) Name() string {
+	return "mysql"
+}
+
+func (d mySQLDialect) String() string {
+	return d.Name()
+}
+
+func (mySQLDialect) Placeholder(index int) string {
+	return "?"
+}
+
+func (mySQLDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
+
+func (mySQLDialect) Like(field, pattern string, negate bool) string {
+	if negate {
+		return fmt.Sprintf("%s not like %s", field, pattern)
+	}
+
+	return fmt.Sprintf("%s like %s", field, pattern)
+}
+
+func (mySQLDialect) LikeCase(field, pattern string, negate, caseSensitive bool) (string, error) {
+	var operator string = "like"
+
+	if negate {
+		operator = "not like"
+	}
+
+	if caseSensitive {
+		return fmt.Sprintf("%s %s binary %s", field, operator, pattern), nil
+	}
+
+	return fmt.Sprintf("lower(%s) %s lower(%s)", field, operator, pattern), nil
+}
+
+func (mySQLDialect) Concat(values ...string) string {
+	return fmt.Sprintf("concat(%s)", strings.Join(values, ", "))
+}
+
+func (d mySQLDialect) LimitOffset(limit, offset uint64, hasOrderBy bool, args []interface{}) (string, []interface{}, error) {
+	var clause string
+
+	if offset > 0 && limit == 0 {
+		return "", nil, ErrLimitIsRequired
+	}
+
+	clause, args = limitOffset(d, limit, offset, args)
+
+	return clause, args, nil
+}
+
+func (mySQLDialect) BoolLiteral(value bool) string {
+	if value {
+		return "true"
+	}
+
+	return "false"
+}
+
+func (mySQLDialect) UpsertStyle() UpsertStyle {
+	return UpsertStyleOnDuplicateKey
+}
+
+func (mySQLDialect) SupportsJoinType(joinType JoinType) bool {
+	return joinType != JoinTypeFull
+}
+
+func (mySQLDialect) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleJoin
+}
+
+func (mySQLDialect) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleFromTarget
+}
+
+func (mySQLDialect) OrderByLimitStyle() OrderByLimitStyle {
+	return OrderByLimitStyleClause
+}
+
+func (mySQLDialect) InsertCommonTableExpressionPlacement() CommonTableExpressionPlacement {
+	return CommonTableExpressionPlacementSelect
+}
+
+func (mySQLDialect) BatchLimit() BatchLimit {
+	return BatchLimit{MaxParameters: 65535}
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Name() string {
+	return "postgres"
+}
+
+func (d postgresDialect) String() string {
+	return d.Name()
+}
+
+func (postgresDialect) Placeholder(index int) string {
+	return fmt.Sprintf("$%d", index)
+}
+
+func (postgresDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func (postgresDialect) Like(field, pattern string, negate bool) string {
+	if negate {
+		return fmt.Sprintf("%s not ilike %s", field, pattern)
+	}
+
+	return fmt.Sprintf("%s ilike %s", field, pattern)
+}
+
+func (postgresDialect) LikeCase(field, pattern string, negate, caseSensitive bool) (string, error) {
+	var operator string = "ilike"
+
+	if caseSensitive {
+		operator = "like"
+	}
+
+	if negate {
+		operator = fmt.Sprintf("not %s", operator)
+	}
+
+	return fmt.Sprintf("%s %s %s", field, operator, pattern), nil
+}
+
+func (postgresDialect) Concat(values ...string) string {
+	return fmt.Sprintf("concat(%s)", strings.Join(values, ", "))
+}
+
+func (d postgresDialect) LimitOffset(limit, offset uint64, hasOrderBy bool, args []interface{}) (string, []interface{}, error) {
+	var clause string
+
+	clause, args = limitOffset(d, limit, offset, args)
+
+	return clause, args, nil
+}
+
+func (postgresDialect) BoolLiteral(value bool) string {
+	if value {
+		return "true"
+	}
+
+	return "false"
+}
+
+func (postgresDialect) SupportsReturning() bool {
+	return true
+}
+
+func (postgresDialect) UpsertStyle() UpsertStyle {
+	return UpsertStyleOnConflict
+}
+
+func (postgresDialect) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFrom
+}
+
+func (postgresDialect) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleUsing
+}
+
+func (postgresDialect) OrderByLimitStyle() OrderByLimitStyle {
+	return OrderByLimitStyleCTID
+}
+
+func (postgresDialect) BatchLimit() BatchLimit {
+	return BatchLimit{MaxParameters: 65535}
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Name() string {
+	return "sqlite"
+}
+
+func (d sqliteDialect) String() string {
+	return d.Name()
+}
+
+func (sqliteDialect) Placeholder(index int) string {
+	return "?"
+}
+
+func (sqliteDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+func (sqliteDialect) Like(field, pattern string, negate bool) string {
+	if negate {
+		return fmt.Sprintf("lower(%s) not like lower(%s)", field, pattern)
+	}
+
+	return fmt.Sprintf("lower(%s) like lower(%s)", field, pattern)
+}
+
+func (d sqliteDialect) LikeCase(field, pattern string, negate, caseSensitive bool) (string, error) {
+	if caseSensitive {
+		return "", fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, CaseSensitivitySensitive, d.Name())
+	}
+
+	return d.Like(field, pattern, negate), nil
+}
+
+func (sqliteDialect) Concat(values ...string) string {
+	return strings.Join(values, " || ")
+}
+
+func (d sqliteDialect) LimitOffset(limit, offset uint64, hasOrderBy bool, args []interface{}) (string, []interface{}, error) {
+	var clause string
+
+	if offset > 0 && limit == 0 {
+		return "", nil, ErrLimitIsRequired
+	}
+
+	clause, args = limitOffset(d, limit, offset, args)
+
+	return clause, args, nil
+}
+
+func (sqliteDialect) BoolLiteral(value bool) string {
+	if value {
+		return "1"
+	}
+
+	return "0"
+}
+
+func (sqliteDialect) SupportsReturning() bool {
+	return true
+}
+
+func (sqliteDialect) UpsertStyle() UpsertStyle {
+	return UpsertStyleOnConflict
+}
+
+func (sqliteDialect) LikeEscape() string {
+	return `escape '\'`
+}
+
+func (sqliteDialect) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFrom
+}
+
+func (sqliteDialect) BatchLimit() BatchLimit {
+	return BatchLimit{MaxParameters: 32766}
+}
+
+type sqlServerDialect struct{}
+
+func (sqlServerDialect) Name() string {
+	return "sqlserver"
+}
+
+func (d sqlServerDialect) String() string {
+	return d.Name()
+}
+
+func (sqlServerDialect) Placeholder(index int) string {
+	return fmt.Sprintf("@p%d", index)
+}
+
+func (sqlServerDialect) QuoteIdentifier(identifier string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+}
+
+func (sqlServerDialect) Like(field, pattern string, negate bool) string {
+	if negate {
+		return fmt.Sprintf("%s not like %s", field, pattern)
+	}
+
+	return fmt.Sprintf("%s like %s", field, pattern)
+}
+
+func (sqlServerDialect) LikeCase(field, pattern string, negate, caseSensitive bool) (string, error) {
+	var (
+		operator  string = "like"
+		collation string = "Latin1_General_CI_AS"
+	)
+
+	if negate {
+		operator = "not like"
+	}
+
+	if caseSensitive {
+		collation = "Latin1_General_CS_AS"
+	}
+
+	return fmt.Sprintf("%s %s %s collate %s", field, operator, pattern, collation), nil
+}
+
+func (sqlServerDialect) Concat(values ...string) string {
+	return fmt.Sprintf("concat(%s)", strings.Join(values, ", "))
+}
+
+func (d sqlServerDialect) LimitOffset(limit, offset uint64, hasOrderBy bool, args []interface{}) (string, []interface{}, error) {
+	var clauses []string = []string{}
+
+	if limit == 0 && offset == 0 {
+		return "", args, nil
+	}
+
+	if !hasOrderBy {
+		clauses = append(clauses, "order by (select null)")
+	}
+
+	args = append(args, offset)
+	clauses = append(clauses, fmt.Sprintf("offset %s rows", d.Placeholder(len(args))))
+
+	if limit > 0 {
+		args = append(args, limit)
+		clauses = append(clauses, fmt.Sprintf("fetch next %s rows only", d.Placeholder(len(args))))
+	}
+
+	return strings.Join(clauses, " "), args, nil
+}
+
+func (sqlServerDialect) BoolLiteral(value bool) string {
+	if value {
+		return "1"
+	}
+
+	return "0"
+}
+
+func (sqlServerDialect) LikeEscape() string {
+	return `escape '\'`
+}
+
+func (sqlServerDialect) UpdateJoinStyle() UpdateJoinStyle {
+	return UpdateJoinStyleFromTarget
+}
+
+func (sqlServerDialect) DeleteJoinStyle() DeleteJoinStyle {
+	return DeleteJoinStyleFromTarget
+}
+
+func (sqlServerDialect) RecursiveKeyword() string {
+	return ""
+}
+
+func (sqlServerDialect) BatchLimit() BatchLimit {
+	return BatchLimit{MaxParameters: 2099, MaxRows: 1000}
+}
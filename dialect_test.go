@@ -0,0 +1,968 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+type oracleDialect struct {
+	postgresDialect
+}
+
+func (oracleDialect) Name() string {
+	return "oracle"
+}
+
+func (oracleDialect) Placeholder(index int) string {
+	return fmt.Sprintf(":%d", index)
+}
+
+type wrappedDialect struct {
+	Dialect
+}
+
+type unnamedDialect struct {
+	postgresDialect
+}
+
+func (unnamedDialect) Name() string {
+	return ""
+}
+
+func TestDialect_RegisterDialect(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation error
+	}{
+		{
+			Name:        "dialect is nil",
+			Dialect:     nil,
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        "dialect name is empty",
+			Dialect:     unnamedDialect{},
+			Expectation: ErrNameIsRequired,
+		},
+		{
+			Name:        "dialect is valid",
+			Dialect:     oracleDialect{},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = RegisterDialect(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestDialect_GetDialect(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		DialectName string
+		Expectation struct {
+			Dialect Dialect
+			Err     error
+		}
+	} = []struct {
+		Name        string
+		DialectName string
+		Expectation struct {
+			Dialect Dialect
+			Err     error
+		}
+	}{
+		{
+			Name:        "dialect is unsupported",
+			DialectName: "unknown",
+			Expectation: struct {
+				Dialect Dialect
+				Err     error
+			}{
+				Dialect: nil,
+				Err:     fmt.Errorf(errUnsupportedDialectf, "unknown"),
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			DialectName: "mysql",
+			Expectation: struct {
+				Dialect Dialect
+				Err     error
+			}{
+				Dialect: DialectMySQL,
+				Err:     nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			DialectName: "postgres",
+			Expectation: struct {
+				Dialect Dialect
+				Err     error
+			}{
+				Dialect: DialectPostgres,
+				Err:     nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			DialectName: "sqlite",
+			Expectation: struct {
+				Dialect Dialect
+				Err     error
+			}{
+				Dialect: DialectSQLite,
+				Err:     nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			DialectName: "sqlserver",
+			Expectation: struct {
+				Dialect Dialect
+				Err     error
+			}{
+				Dialect: DialectSQLServer,
+				Err:     nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualDialect Dialect
+				actualErr     error
+			)
+
+			actualDialect, actualErr = GetDialect(testCases[i].DialectName)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Dialect != actualDialect {
+				t.Errorf("expectation dialect is %v, got %v", testCases[i].Expectation.Dialect, actualDialect)
+			}
+		})
+	}
+}
+
+func TestDialect_CustomDialect(t *testing.T) {
+	var (
+		dialect     Dialect
+		selectQuery *SelectQuery
+		query       string
+		args        []interface{}
+		err         error
+	)
+
+	err = RegisterDialect(oracleDialect{})
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	dialect, err = GetDialect("oracle")
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	selectQuery = Select(NewField("field1")).
+		From(NewTable("table1")).
+		Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1")))
+
+	query, args, err = selectQuery.ToSQLWithArgs(dialect, []interface{}{})
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if query != "select field1 from table1 where field1 = :1" {
+		t.Errorf("expectation query is select field1 from table1 where field1 = :1, got %s", query)
+	}
+
+	if len(args) != 1 {
+		t.Errorf("expectation length of args is 1, got %d", len(args))
+	}
+}
+
+func TestDialect_Like(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Negate      bool
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Negate      bool
+		Expectation string
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Negate:      false,
+			Expectation: "field1 like concat('%', ?, '%')",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with negate", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Negate:      true,
+			Expectation: "field1 not ilike concat('%', $1, '%')",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Negate:      false,
+			Expectation: "lower(field1) like lower('%' || ? || '%')",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with negate", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Negate:      true,
+			Expectation: "field1 not like concat('%', @p1, '%')",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				pattern string
+				actual  string
+			)
+
+			pattern = testCases[i].Dialect.Concat("'%'", testCases[i].Dialect.Placeholder(1), "'%'")
+			actual = testCases[i].Dialect.Like("field1", pattern, testCases[i].Negate)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestDialect_LikeCase(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		Dialect       Dialect
+		Negate        bool
+		CaseSensitive bool
+		Expectation   struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name          string
+		Dialect       Dialect
+		Negate        bool
+		CaseSensitive bool
+		Expectation   struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:          fmt.Sprintf("dialect %s case sensitive", DialectMySQL),
+			Dialect:       DialectMySQL,
+			Negate:        false,
+			CaseSensitive: true,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "field1 like binary ?",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case insensitive with negate", DialectMySQL),
+			Dialect:       DialectMySQL,
+			Negate:        true,
+			CaseSensitive: false,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "lower(field1) not like lower(?)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case sensitive with negate", DialectPostgres),
+			Dialect:       DialectPostgres,
+			Negate:        true,
+			CaseSensitive: true,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "field1 not like $1",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case insensitive", DialectPostgres),
+			Dialect:       DialectPostgres,
+			Negate:        false,
+			CaseSensitive: false,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "field1 ilike $1",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case sensitive", DialectSQLite),
+			Dialect:       DialectSQLite,
+			Negate:        false,
+			CaseSensitive: true,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, CaseSensitivitySensitive, DialectSQLite.Name()),
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case insensitive", DialectSQLite),
+			Dialect:       DialectSQLite,
+			Negate:        false,
+			CaseSensitive: false,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "lower(field1) like lower(?)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case sensitive", DialectSQLServer),
+			Dialect:       DialectSQLServer,
+			Negate:        false,
+			CaseSensitive: true,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "field1 like @p1 collate Latin1_General_CS_AS",
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s case insensitive with negate", DialectSQLServer),
+			Dialect:       DialectSQLServer,
+			Negate:        true,
+			CaseSensitive: false,
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "field1 not like @p1 collate Latin1_General_CI_AS",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, actualErr = testCases[i].Dialect.(LikeCaseDialect).LikeCase("field1", testCases[i].Dialect.Placeholder(1), testCases[i].Negate, testCases[i].CaseSensitive)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
+
+func TestDialect_LimitOffset(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Limit       uint64
+		Offset      uint64
+		HasOrderBy  bool
+		Expectation struct {
+			Clause string
+			Args   []interface{}
+			Err    error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Limit       uint64
+		Offset      uint64
+		HasOrderBy  bool
+		Expectation struct {
+			Clause string
+			Args   []interface{}
+			Err    error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("dialect %s with offset and without limit", DialectMySQL),
+			Dialect: DialectMySQL,
+			Limit:   0,
+			Offset:  20,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "",
+				Args:   nil,
+				Err:    ErrLimitIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with limit and offset", DialectPostgres),
+			Dialect: DialectPostgres,
+			Limit:   10,
+			Offset:  20,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "limit $1 offset $2",
+				Args:   []interface{}{10, 20},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with offset and without limit", DialectPostgres),
+			Dialect: DialectPostgres,
+			Limit:   0,
+			Offset:  20,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "offset $1",
+				Args:   []interface{}{20},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with offset and without limit", DialectSQLite),
+			Dialect: DialectSQLite,
+			Limit:   0,
+			Offset:  20,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "",
+				Args:   nil,
+				Err:    ErrLimitIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s without limit and offset", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			Limit:   0,
+			Offset:  0,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "",
+				Args:   []interface{}{},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with limit and without order by", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			Limit:   10,
+			Offset:  0,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "order by (select null) offset @p1 rows fetch next @p2 rows only",
+				Args:   []interface{}{0, 10},
+				Err:    nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("dialect %s with offset and order by", DialectSQLServer),
+			Dialect:    DialectSQLServer,
+			Limit:      0,
+			Offset:     20,
+			HasOrderBy: true,
+			Expectation: struct {
+				Clause string
+				Args   []interface{}
+				Err    error
+			}{
+				Clause: "offset @p1 rows",
+				Args:   []interface{}{20},
+				Err:    nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualClause string
+				actualArgs   []interface{}
+				actualErr    error
+			)
+
+			actualClause, actualArgs, actualErr = testCases[i].Dialect.LimitOffset(testCases[i].Limit, testCases[i].Offset, testCases[i].HasOrderBy, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Clause != actualClause {
+				t.Errorf("expectation clause is %s, got %s", testCases[i].Expectation.Clause, actualClause)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Fatalf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
+
+func TestDialect_BoolLiteral(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Value       bool
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Value       bool
+		Expectation string
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Value:       true,
+			Expectation: "true",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Value:       false,
+			Expectation: "false",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Value:       true,
+			Expectation: "1",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Value:       false,
+			Expectation: "0",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			boolLiteralDialect, ok := testCases[i].Dialect.(BoolLiteralDialect)
+			if !ok {
+				t.Fatalf("dialect %s does not implement bool literal", testCases[i].Dialect.Name())
+			}
+
+			var actual string = boolLiteralDialect.BoolLiteral(testCases[i].Value)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestDialect_BatchLimit(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     BatchLimitDialect
+		Expectation BatchLimit
+	} = []struct {
+		Name        string
+		Dialect     BatchLimitDialect
+		Expectation BatchLimit
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     mySQLDialect{},
+			Expectation: BatchLimit{MaxParameters: 65535},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:     postgresDialect{},
+			Expectation: BatchLimit{MaxParameters: 65535},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     sqliteDialect{},
+			Expectation: BatchLimit{MaxParameters: 32766},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect:     sqlServerDialect{},
+			Expectation: BatchLimit{MaxParameters: 2099, MaxRows: 1000},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual BatchLimit = testCases[i].Dialect.BatchLimit()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation batch limit is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestDialect_LikeEscape(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation string
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Expectation: "",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Expectation: "",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Expectation: `escape '\'`,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Expectation: `escape '\'`,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string
+
+			if likeEscapeDialect, ok := testCases[i].Dialect.(LikeEscapeDialect); ok {
+				actual = likeEscapeDialect.LikeEscape()
+			}
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation like escape is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestDialect_UpsertStyle(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation UpsertStyle
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation UpsertStyle
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Expectation: UpsertStyleOnDuplicateKey,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Expectation: UpsertStyleOnConflict,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Expectation: UpsertStyleOnConflict,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Expectation: "",
+		},
+		{
+			Name:        "dialect mariadb",
+			Dialect:     mariaDBDialect{},
+			Expectation: UpsertStyleOnDuplicateKey,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual UpsertStyle = upsertStyle(testCases[i].Dialect)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation upsert style is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestDialect_JoinStyle(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation struct {
+			UpdateJoinStyle UpdateJoinStyle
+			DeleteJoinStyle DeleteJoinStyle
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation struct {
+			UpdateJoinStyle UpdateJoinStyle
+			DeleteJoinStyle DeleteJoinStyle
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				UpdateJoinStyle UpdateJoinStyle
+				DeleteJoinStyle DeleteJoinStyle
+			}{
+				UpdateJoinStyle: UpdateJoinStyleJoin,
+				DeleteJoinStyle: DeleteJoinStyleFromTarget,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				UpdateJoinStyle UpdateJoinStyle
+				DeleteJoinStyle DeleteJoinStyle
+			}{
+				UpdateJoinStyle: UpdateJoinStyleFrom,
+				DeleteJoinStyle: DeleteJoinStyleUsing,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				UpdateJoinStyle UpdateJoinStyle
+				DeleteJoinStyle DeleteJoinStyle
+			}{
+				UpdateJoinStyle: UpdateJoinStyleFrom,
+				DeleteJoinStyle: "",
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				UpdateJoinStyle UpdateJoinStyle
+				DeleteJoinStyle DeleteJoinStyle
+			}{
+				UpdateJoinStyle: UpdateJoinStyleFromTarget,
+				DeleteJoinStyle: DeleteJoinStyleFromTarget,
+			},
+		},
+		{
+			Name:    "dialect without join capability",
+			Dialect: wrappedDialect{DialectMySQL},
+			Expectation: struct {
+				UpdateJoinStyle UpdateJoinStyle
+				DeleteJoinStyle DeleteJoinStyle
+			}{
+				UpdateJoinStyle: "",
+				DeleteJoinStyle: "",
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualUpdateJoinStyle UpdateJoinStyle = updateJoinStyle(testCases[i].Dialect)
+				actualDeleteJoinStyle DeleteJoinStyle = deleteJoinStyle(testCases[i].Dialect)
+			)
+
+			if testCases[i].Expectation.UpdateJoinStyle != actualUpdateJoinStyle {
+				t.Errorf("expectation update join style is %s, got %s", testCases[i].Expectation.UpdateJoinStyle, actualUpdateJoinStyle)
+			}
+
+			if testCases[i].Expectation.DeleteJoinStyle != actualDeleteJoinStyle {
+				t.Errorf("expectation delete join style is %s, got %s", testCases[i].Expectation.DeleteJoinStyle, actualDeleteJoinStyle)
+			}
+		})
+	}
+}
+
+func TestDialect_CommonTableExpression(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation struct {
+			RecursiveKeyword string
+			InsertPlacement  CommonTableExpressionPlacement
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation struct {
+			RecursiveKeyword string
+			InsertPlacement  CommonTableExpressionPlacement
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				RecursiveKeyword string
+				InsertPlacement  CommonTableExpressionPlacement
+			}{
+				RecursiveKeyword: "recursive",
+				InsertPlacement:  CommonTableExpressionPlacementSelect,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				RecursiveKeyword string
+				InsertPlacement  CommonTableExpressionPlacement
+			}{
+				RecursiveKeyword: "recursive",
+				InsertPlacement:  CommonTableExpressionPlacementStatement,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				RecursiveKeyword string
+				InsertPlacement  CommonTableExpressionPlacement
+			}{
+				RecursiveKeyword: "recursive",
+				InsertPlacement:  CommonTableExpressionPlacementStatement,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				RecursiveKeyword string
+				InsertPlacement  CommonTableExpressionPlacement
+			}{
+				RecursiveKeyword: "",
+				InsertPlacement:  CommonTableExpressionPlacementStatement,
+			},
+		},
+		{
+			Name:    "dialect mariadb",
+			Dialect: mariaDBDialect{},
+			Expectation: struct {
+				RecursiveKeyword string
+				InsertPlacement  CommonTableExpressionPlacement
+			}{
+				RecursiveKeyword: "recursive",
+				InsertPlacement:  CommonTableExpressionPlacementSelect,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualRecursiveKeyword string                         = recursiveKeyword(testCases[i].Dialect)
+				actualInsertPlacement  CommonTableExpressionPlacement = insertCommonTableExpressionPlacement(testCases[i].Dialect)
+			)
+
+			if testCases[i].Expectation.RecursiveKeyword != actualRecursiveKeyword {
+				t.Errorf("expectation recursive keyword is %s, got %s", testCases[i].Expectation.RecursiveKeyword, actualRecursiveKeyword)
+			}
+
+			if testCases[i].Expectation.InsertPlacement != actualInsertPlacement {
+				t.Errorf("expectation insert placement is %s, got %s", testCases[i].Expectation.InsertPlacement, actualInsertPlacement)
+			}
+		})
+	}
+}
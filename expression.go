@@ -0,0 +1,146 @@
+package simple_query
+
+import "fmt"
+
+type Expression struct {
+	Left     interface{}
+	Operator ArithmeticOperator
+	Right    interface{}
+}
+
+func NewExpression(left interface{}, operator ArithmeticOperator, right interface{}) *Expression {
+	return &Expression{
+		Left:     left,
+		Operator: operator,
+		Right:    right,
+	}
+}
+
+func Add(left, right interface{}) *Expression {
+	return NewExpression(left, ArithmeticOperatorAdd, right)
+}
+
+func Subtract(left, right interface{}) *Expression {
+	return NewExpression(left, ArithmeticOperatorSubtract, right)
+}
+
+func Multiply(left, right interface{}) *Expression {
+	return NewExpression(left, ArithmeticOperatorMultiply, right)
+}
+
+func Divide(left, right interface{}) *Expression {
+	return NewExpression(left, ArithmeticOperatorDivide, right)
+}
+
+func Modulo(left, right interface{}) *Expression {
+	return NewExpression(left, ArithmeticOperatorModulo, right)
+}
+
+func (e *Expression) validate(dialect Dialect) error {
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	if e.Operator == "" {
+		return ErrOperatorIsRequired
+	}
+
+	if _, ok := arithmeticOperatorMap[e.Operator]; !ok {
+		return fmt.Errorf(errUnsupportedArithmeticOperatorf, e.Operator)
+	}
+
+	return nil
+}
+
+func (e *Expression) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		left  string
+		right string
+		err   error
+	)
+
+	err = e.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	left, args, err = expressionValueToSQLWithArgs(dialect, args, e.Left, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	right, args, err = expressionValueToSQLWithArgs(dialect, args, e.Right, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("%s %s %s", left, arithmeticOperatorMap[e.Operator], right), args, nil
+}
+
+func (e *Expression) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return e.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func setValueToSQLWithArgs(dialect Dialect, args []interface{}, value interface{}, options renderOptions) (string, []interface{}, error) {
+	if expression, ok := value.(*Expression); ok && expression != nil {
+		return expression.toSQLWithArgs(dialect, args, options)
+	}
+
+	return expressionValueToSQLWithArgs(dialect, args, value, options)
+}
+
+func expressionValueToSQLWithArgs(dialect Dialect, args []interface{}, value interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		query string
+		err   error
+	)
+
+	switch expressionValue := value.(type) {
+	case *Field:
+		if expressionValue == nil {
+			return "", nil, ErrFieldIsNil
+		}
+
+		return expressionValue.toSQLWithArgs(dialect, args, options)
+
+	case *Expression:
+		if expressionValue == nil {
+			return "", nil, ErrValueIsRequired
+		}
+
+		query, args, err = expressionValue.toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s)", query), args, nil
+
+	case *ExcludedValue:
+		if expressionValue == nil {
+			return "", nil, ErrValueIsRequired
+		}
+
+		query, err = expressionValue.toSQL(dialect, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return query, args, nil
+
+	case *SelectQuery:
+		if expressionValue == nil {
+			return "", nil, ErrValueIsRequired
+		}
+
+		query, args, err = expressionValue.toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s)", query), args, nil
+
+	default:
+		args = append(args, value)
+		return getPlaceholder(dialect, len(args), len(args)), args, nil
+	}
+}
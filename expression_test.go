@@ -0,0 +1,249 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpression_NewExpression(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expression  *Expression
+		Expectation *Expression
+	} = []struct {
+		Name        string
+		Expression  *Expression
+		Expectation *Expression
+	}{
+		{
+			Name:        "new expression",
+			Expression:  NewExpression(NewField("field1"), ArithmeticOperatorAdd, 1),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorAdd, Right: 1},
+		},
+		{
+			Name:        "add",
+			Expression:  Add(NewField("field1"), 1),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorAdd, Right: 1},
+		},
+		{
+			Name:        "subtract",
+			Expression:  Subtract(NewField("field1"), 1),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorSubtract, Right: 1},
+		},
+		{
+			Name:        "multiply",
+			Expression:  Multiply(NewField("field1"), 2),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorMultiply, Right: 2},
+		},
+		{
+			Name:        "divide",
+			Expression:  Divide(NewField("field1"), 2),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorDivide, Right: 2},
+		},
+		{
+			Name:        "modulo",
+			Expression:  Modulo(NewField("field1"), 2),
+			Expectation: &Expression{Left: &Field{Column: "field1"}, Operator: ArithmeticOperatorModulo, Right: 2},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if !deepEqual(testCases[i].Expectation, testCases[i].Expression) {
+				t.Errorf("expectation expression is %+v, got %+v", testCases[i].Expectation, testCases[i].Expression)
+			}
+
+			if testCases[i].Expectation.Operator != testCases[i].Expression.Operator {
+				t.Errorf("expectation operator is %s, got %s", testCases[i].Expectation.Operator, testCases[i].Expression.Operator)
+			}
+		})
+	}
+}
+
+func TestExpression_validate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation error
+	} = []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation error
+	}{
+		{
+			Name:        "dialect is empty",
+			Expression:  Add(NewField("field1"), 1),
+			Dialect:     nil,
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        "operator is empty",
+			Expression:  NewExpression(NewField("field1"), "", 1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrOperatorIsRequired,
+		},
+		{
+			Name:        "operator is unsupported",
+			Expression:  NewExpression(NewField("field1"), "power", 1),
+			Dialect:     DialectPostgres,
+			Expectation: fmt.Errorf(errUnsupportedArithmeticOperatorf, "power"),
+		},
+		{
+			Name:        "expression is valid",
+			Expression:  Add(NewField("field1"), 1),
+			Dialect:     DialectPostgres,
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].Expression.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestExpression_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:       "expression is invalid",
+			Expression: NewExpression(NewField("field1"), "", 1),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOperatorIsRequired,
+			},
+		},
+		{
+			Name:       "left is invalid",
+			Expression: Add(&Field{}, 1),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:       "right is nil field",
+			Expression: Add(NewField("field1"), (*Field)(nil)),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsNil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("dialect %s with field and value", DialectPostgres),
+			Expression: Add(NewField("field1"), 1),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 + $2",
+				Args:  []interface{}{"value1", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("dialect %s with nested expression, function and subquery", DialectMySQL),
+			Expression: Multiply(Add(Coalesce(NewField("field1"), 0), 1), Select(Max(NewField("field2"))).From(NewTable("table2"))),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(coalesce(field1, ?) + ?) * (select max(field2) from table2)",
+				Args:  []interface{}{"value1", 0, 1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Expression.ToSQLWithArgs(testCases[i].Dialect, []interface{}{"value1"})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Fatalf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
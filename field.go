@@ -1,12 +1,19 @@
 package simple_query
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Field struct {
-	Table       string
-	Column      string
-	SelectQuery *SelectQuery
-	Alias       string
+	Table        string
+	Column       string
+	SelectQuery  *SelectQuery
+	Function     string
+	FunctionArgs []interface{}
+	Alias        string
+
+	system bool
 }
 
 func NewField(column string) *Field {
@@ -15,12 +22,58 @@ func NewField(column string) *Field {
 	}
 }
 
+func newSystemField(column string) *Field {
+	return &Field{
+		Column: column,
+		system: true,
+	}
+}
+
 func NewSelectQueryField(selectQuery *SelectQuery) *Field {
 	return &Field{
 		SelectQuery: selectQuery,
 	}
 }
 
+func NewFuncField(function string, args ...interface{}) *Field {
+	return &Field{
+		Function:     function,
+		FunctionArgs: args,
+	}
+}
+
+func Count(args ...interface{}) *Field {
+	return NewFuncField("count", args...)
+}
+
+func Sum(arg interface{}) *Field {
+	return NewFuncField("sum", arg)
+}
+
+func Avg(arg interface{}) *Field {
+	return NewFuncField("avg", arg)
+}
+
+func Min(arg interface{}) *Field {
+	return NewFuncField("min", arg)
+}
+
+func Max(arg interface{}) *Field {
+	return NewFuncField("max", arg)
+}
+
+func Coalesce(args ...interface{}) *Field {
+	return NewFuncField("coalesce", args...)
+}
+
+func Lower(arg interface{}) *Field {
+	return NewFuncField("lower", arg)
+}
+
+func Upper(arg interface{}) *Field {
+	return NewFuncField("upper", arg)
+}
+
 func (f *Field) FromTable(table string) *Field {
 	f.Table = table
 	return f
@@ -31,12 +84,30 @@ func (f *Field) As(alias string) *Field {
 	return f
 }
 
+func (f *Field) isAggregate() bool {
+	if f == nil {
+		return false
+	}
+
+	if aggregateFunctionMap[strings.ToLower(f.Function)] {
+		return true
+	}
+
+	for i := range f.FunctionArgs {
+		if argField, ok := f.FunctionArgs[i].(*Field); ok && argField.isAggregate() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (f *Field) validate(dialect Dialect) error {
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
-	if f.Column == "" && f.SelectQuery == nil {
+	if f.Column == "" && f.SelectQuery == nil && f.Function == "" {
 		return ErrColumnIsRequired
 	}
 
@@ -44,14 +115,58 @@ func (f *Field) validate(dialect Dialect) error {
 		return ErrConflictFieldColumnAndFieldSelectQuery
 	}
 
+	if f.Column != "" && f.Function != "" {
+		return ErrConflictFieldColumnAndFieldFunction
+	}
+
+	if f.SelectQuery != nil && f.Function != "" {
+		return ErrConflictFieldSelectQueryAndFieldFunction
+	}
+
 	if f.Alias == "" && f.SelectQuery != nil {
 		return ErrAliasIsRequired
 	}
 
+	for i := range f.FunctionArgs {
+		if argField, ok := f.FunctionArgs[i].(*Field); ok && argField == nil {
+			return ErrFieldIsNil
+		}
+	}
+
 	return nil
 }
 
-func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (f *Field) functionToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		functionArgs []string
+		err          error
+	)
+
+	err = options.function(f.Function)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(f.FunctionArgs) == 0 && aggregateFunctionMap[strings.ToLower(f.Function)] {
+		return fmt.Sprintf("%s(*)", f.Function), args, nil
+	}
+
+	functionArgs = []string{}
+	for i := range f.FunctionArgs {
+		var functionArg string
+
+		functionArg, args, err = expressionValueToSQLWithArgs(dialect, args, f.FunctionArgs[i], options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		functionArgs = append(functionArgs, functionArg)
+	}
+
+	return fmt.Sprintf("%s(%s)", f.Function, strings.Join(functionArgs, ", ")), args, nil
+}
+
+func (f *Field) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		field string
 		err   error
@@ -62,9 +177,17 @@ func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 		return "", nil, err
 	}
 
-	field = f.Column
+	if f.system {
+		field = options.quote(dialect, f.Column)
+	} else {
+		field, err = options.identifier(dialect, f.Column)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
 	if f.SelectQuery != nil {
-		field, args, err = f.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+		field, args, err = f.SelectQuery.toSQLWithArgsWithAlias(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -72,27 +195,56 @@ func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 		field = fmt.Sprintf("(%s)", field)
 	}
 
-	if f.Table != "" && f.SelectQuery == nil {
-		field = fmt.Sprintf("%s.%s", f.Table, field)
+	if f.Function != "" {
+		field, args, err = f.functionToSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if f.Table != "" && f.Column != "" {
+		var table string
+
+		table, err = options.identifier(dialect, f.Table)
+		if err != nil {
+			return "", nil, err
+		}
+
+		field = fmt.Sprintf("%s.%s", table, field)
 	}
 
 	return field, args, nil
 }
 
-func (f *Field) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func (f *Field) toSQLWithArgsWithAlias(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		fieldWithAlias string
 		err            error
 	)
 
-	fieldWithAlias, args, err = f.ToSQLWithArgs(dialect, args)
+	fieldWithAlias, args, err = f.toSQLWithArgs(dialect, args, options)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if f.Alias != "" {
-		fieldWithAlias = fmt.Sprintf("%s as %s", fieldWithAlias, f.Alias)
+		var alias string
+
+		alias, err = options.alias(dialect, f.Alias)
+		if err != nil {
+			return "", nil, err
+		}
+
+		fieldWithAlias = fmt.Sprintf("%s as %s", fieldWithAlias, alias)
 	}
 
 	return fieldWithAlias, args, nil
 }
+
+func (f *Field) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgsWithAlias(dialect, args, renderOptions{})
+}
@@ -31,6 +31,20 @@ func testField_FieldEquality(t *testing.T, expectation, actual *Field) {
 		t.Errorf("expectation select query is %+v, got %+v", expectation.SelectQuery, actual.SelectQuery)
 	}
 
+	if expectation.Function != actual.Function {
+		t.Errorf("expectation function is %s, got %s", expectation.Function, actual.Function)
+	}
+
+	if len(expectation.FunctionArgs) != len(actual.FunctionArgs) {
+		t.Errorf("expectation length of function args is %d, got %d", len(expectation.FunctionArgs), len(actual.FunctionArgs))
+	} else {
+		for i := range expectation.FunctionArgs {
+			if !deepEqual(expectation.FunctionArgs[i], actual.FunctionArgs[i]) {
+				t.Errorf("expectation element of function args is %+v, got %+v", expectation.FunctionArgs[i], actual.FunctionArgs[i])
+			}
+		}
+	}
+
 	if expectation.Table != actual.Table {
 		t.Errorf("expectation field is %s, got %s", expectation.Table, actual.Table)
 	}
@@ -66,6 +80,81 @@ func TestField_NewSelectQueryField(t *testing.T) {
 	)
 }
 
+func TestField_NewFuncField(t *testing.T) {
+	testField_FieldEquality(
+		t,
+		&Field{
+			Function: "concat",
+			FunctionArgs: []interface{}{
+				&Field{
+					Column: "field1",
+				},
+				"value1",
+			},
+		},
+		NewFuncField("concat", NewField("field1"), "value1"),
+	)
+}
+
+func TestField_FunctionHelpers(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Field       *Field
+		Expectation *Field
+	} = []struct {
+		Name        string
+		Field       *Field
+		Expectation *Field
+	}{
+		{
+			Name:        "count",
+			Field:       Count(),
+			Expectation: &Field{Function: "count"},
+		},
+		{
+			Name:        "sum",
+			Field:       Sum(NewField("field1")),
+			Expectation: &Field{Function: "sum", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+		{
+			Name:        "avg",
+			Field:       Avg(NewField("field1")),
+			Expectation: &Field{Function: "avg", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+		{
+			Name:        "min",
+			Field:       Min(NewField("field1")),
+			Expectation: &Field{Function: "min", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+		{
+			Name:        "max",
+			Field:       Max(NewField("field1")),
+			Expectation: &Field{Function: "max", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+		{
+			Name:        "coalesce",
+			Field:       Coalesce(NewField("field1"), 0),
+			Expectation: &Field{Function: "coalesce", FunctionArgs: []interface{}{&Field{Column: "field1"}, 0}},
+		},
+		{
+			Name:        "lower",
+			Field:       Lower(NewField("field1")),
+			Expectation: &Field{Function: "lower", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+		{
+			Name:        "upper",
+			Field:       Upper(NewField("field1")),
+			Expectation: &Field{Function: "upper", FunctionArgs: []interface{}{&Field{Column: "field1"}}},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			testField_FieldEquality(t, testCases[i].Expectation, testCases[i].Field)
+		})
+	}
+}
+
 func TestField_FromTable(t *testing.T) {
 	testField_FieldEquality(t, &Field{Column: "field1", Table: "table1"}, NewField("field1").FromTable("table1"))
 }
@@ -89,7 +178,7 @@ func TestField_validate(t *testing.T) {
 		{
 			Name:        "dialect is empty",
 			Field:       &Field{},
-			Dialect:     "",
+			Dialect:     nil,
 			Expectation: ErrDialectIsRequired,
 		},
 		{
@@ -115,6 +204,33 @@ func TestField_validate(t *testing.T) {
 			Dialect:     DialectPostgres,
 			Expectation: ErrAliasIsRequired,
 		},
+		{
+			Name: "column is not empty and function is not empty",
+			Field: &Field{
+				Column:   "field1",
+				Function: "sum",
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictFieldColumnAndFieldFunction,
+		},
+		{
+			Name: "select query is not nil and function is not empty",
+			Field: &Field{
+				SelectQuery: &SelectQuery{},
+				Function:    "sum",
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictFieldSelectQueryAndFieldFunction,
+		},
+		{
+			Name: "function args element is nil field",
+			Field: &Field{
+				Function:     "sum",
+				FunctionArgs: []interface{}{(*Field)(nil)},
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldIsNil,
+		},
 		{
 			Name: "field is valid",
 			Field: &Field{
@@ -216,6 +332,70 @@ func TestField_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: "function args element to sql with args is error",
+			Field: &Field{
+				Function: "sum",
+				FunctionArgs: []interface{}{
+					&Field{},
+				},
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name: "aggregate function without args",
+			Field: &Field{
+				Function: "count",
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "count(*)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "function with field and value args",
+			Field: &Field{
+				Table:    "table1",
+				Function: "coalesce",
+				FunctionArgs: []interface{}{
+					&Field{
+						Table:  "table1",
+						Column: "field1",
+					},
+					&Field{
+						Function: "lower",
+						FunctionArgs: []interface{}{
+							&Field{
+								Column: "field2",
+							},
+						},
+					},
+					"value1",
+				},
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "coalesce(table1.field1, lower(field2), $1)",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
 		{
 			Name: "table is not empty and select query is nil",
 			Field: &Field{
@@ -7,11 +7,12 @@ import (
 )
 
 type Filter struct {
-	Logic    Logic
-	Field    *Field
-	Operator Operator
-	Value    *FilterValue
-	Filters  []*Filter
+	Logic           Logic
+	Field           *Field
+	Operator        Operator
+	Value           *FilterValue
+	CaseSensitivity CaseSensitivity
+	Filters         []*Filter
 }
 
 func NewFilter() *Filter {
@@ -30,6 +31,11 @@ func (f *Filter) SetCondition(field *Field, operator Operator, value *FilterValu
 	return f
 }
 
+func (f *Filter) SetCaseSensitivity(caseSensitivity CaseSensitivity) *Filter {
+	f.CaseSensitivity = caseSensitivity
+	return f
+}
+
 func (f *Filter) AddFilter(field *Field, operator Operator, value *FilterValue) *Filter {
 	f.Filters = append(f.Filters, &Filter{Field: field, Operator: operator, Value: value})
 	return f
@@ -40,14 +46,36 @@ func (f *Filter) AddFilters(filters ...*Filter) *Filter {
 	return f
 }
 
+func (f *Filter) hasAggregate() bool {
+	if f == nil {
+		return false
+	}
+
+	if f.Field.isAggregate() {
+		return true
+	}
+
+	if f.Value != nil && f.Value.Field.isAggregate() {
+		return true
+	}
+
+	for i := range f.Filters {
+		if f.Filters[i].hasAggregate() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (f *Filter) validate(dialect Dialect) error {
 	var reflectValue reflect.Value
 
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
-	if f.Value != nil && f.Value.SelectQuery == nil {
+	if f.Value != nil && f.Value.SelectQuery == nil && f.Value.Field == nil {
 		reflectValue = reflect.ValueOf(f.Value.Value)
 	}
 
@@ -60,7 +88,7 @@ func (f *Filter) validate(dialect Dialect) error {
 	}
 
 	if f.Logic != "" && f.Value != nil &&
-		(f.Value.SelectQuery != nil ||
+		(f.Value.SelectQuery != nil || f.Value.Field != nil ||
 			(f.Value.SelectQuery == nil && (f.Value.Value != nil || reflectValue.Kind() != reflect.Invalid))) {
 		return ErrValueIsNotNil
 	}
@@ -69,11 +97,33 @@ func (f *Filter) validate(dialect Dialect) error {
 		return ErrFiltersIsRequired
 	}
 
+	if f.CaseSensitivity != "" && f.CaseSensitivity != CaseSensitivitySensitive && f.CaseSensitivity != CaseSensitivityInsensitive {
+		return fmt.Errorf(errUnsupportedCaseSensitivityf, f.CaseSensitivity)
+	}
+
 	if f.Logic == "" && len(f.Filters) > 0 {
 		return ErrLogicIsRequired
 	}
 
-	if f.Logic == "" && len(f.Filters) == 0 {
+	if f.Logic == "" && len(f.Filters) == 0 && (f.Operator == OperatorExists || f.Operator == OperatorNotExists) {
+		if f.Field != nil {
+			return ErrFieldIsNotEmpty
+		}
+
+		if f.Value == nil {
+			return ErrValueIsRequired
+		}
+
+		if f.Value.Field != nil {
+			return fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", f.Operator)
+		}
+
+		if f.Value.SelectQuery == nil {
+			return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
+		}
+	}
+
+	if f.Logic == "" && len(f.Filters) == 0 && f.Operator != OperatorExists && f.Operator != OperatorNotExists {
 		if f.Field == nil {
 			return ErrFieldIsRequired
 		}
@@ -84,18 +134,23 @@ func (f *Filter) validate(dialect Dialect) error {
 
 		if f.Operator != OperatorIsNull && f.Operator != OperatorIsNotNull &&
 			(f.Value == nil ||
-				(f.Value != nil && f.Value.SelectQuery == nil && f.Value.Value == nil && reflectValue.Kind() == reflect.Invalid)) {
+				(f.Value != nil && f.Value.SelectQuery == nil && f.Value.Field == nil && f.Value.Value == nil && reflectValue.Kind() == reflect.Invalid)) {
 			return ErrValueIsRequired
 		}
 
 		if (f.Operator == OperatorIsNull || f.Operator == OperatorIsNotNull) &&
 			f.Value != nil &&
-			(f.Value.SelectQuery != nil ||
+			(f.Value.SelectQuery != nil || f.Value.Field != nil ||
 				(f.Value.SelectQuery == nil && (f.Value.Value != nil || reflectValue.Kind() != reflect.Invalid))) {
 			return ErrValueIsNotNil
 		}
 
+		if f.Value != nil && f.Value.Field != nil && !supportsFieldValue(f.Operator) {
+			return fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", f.Operator)
+		}
+
 		if f.Operator != OperatorIn && f.Operator != OperatorNotIn &&
+			f.Operator != OperatorBetween && f.Operator != OperatorNotBetween &&
 			f.Value != nil &&
 			(f.Value.SelectQuery == nil && (reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array)) {
 			return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
@@ -110,6 +165,32 @@ func (f *Filter) validate(dialect Dialect) error {
 				return ErrValueIsRequired
 			}
 		}
+
+		if (f.Operator == OperatorStartsWith || f.Operator == OperatorEndsWith || f.Operator == OperatorContains || f.Operator == OperatorLikePattern) && f.Value != nil {
+			if f.Value.SelectQuery != nil {
+				return fmt.Errorf(errUnsupportedValueTypeForOperatorf, "select query", f.Operator)
+			}
+
+			if reflectValue.Kind() != reflect.String {
+				return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
+			}
+		}
+
+		if (f.Operator == OperatorBetween || f.Operator == OperatorNotBetween) && f.Value != nil {
+			if f.Value.SelectQuery != nil {
+				return fmt.Errorf(errUnsupportedValueTypeForOperatorf, "select query", f.Operator)
+			}
+
+			if _, ok := f.Value.Value.(Range); !ok {
+				if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+					return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
+				}
+
+				if reflectValue.Len() != 2 {
+					return ErrValueLengthIsNotEqualToTwo
+				}
+			}
+		}
 	}
 
 	for i := range f.Filters {
@@ -122,7 +203,17 @@ func (f *Filter) validate(dialect Dialect) error {
 	return nil
 }
 
-func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool) (string, []interface{}, error) {
+func supportsFieldValue(operator Operator) bool {
+	switch operator {
+	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual,
+		OperatorLike, OperatorNotLike, OperatorLikeCaseSensitive, OperatorNotLikeCaseSensitive, OperatorLikeCaseInsensitive, OperatorNotLikeCaseInsensitive:
+		return true
+	}
+
+	return false
+}
+
+func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool, options renderOptions) (string, []interface{}, error) {
 	var (
 		field                string
 		queryValue           string
@@ -137,8 +228,8 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 		err                  error
 	)
 
-	if f.Operator != "" {
-		field, args, err = f.Field.ToSQLWithArgsWithAlias(dialect, args)
+	if f.Operator != "" && f.Operator != OperatorExists && f.Operator != OperatorNotExists {
+		field, args, err = f.Field.toSQLWithArgs(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -146,7 +237,7 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 
 	switch f.Operator {
 	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
-		queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+		queryValue, args, err = f.Value.toSQLWithArgs(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -191,7 +282,7 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 			placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
 			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, placeholder)
 		} else {
-			queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+			queryValue, args, err = f.Value.toSQLWithArgs(dialect, args, options)
 			if err != nil {
 				return "", nil, err
 			}
@@ -202,31 +293,93 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 
 		return conditionQuery, args, nil
 
-	case OperatorLike, OperatorNotLike:
-		queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+	case OperatorExists, OperatorNotExists:
+		queryValue, args, err = f.Value.toSQLWithArgs(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
 
-		conditionQueryFormat = "%s %s concat('%%', %s, '%%')"
+		conditionQueryFormat = "%s %s"
+		filterOperator = filterOperatorMap[f.Operator]
+		conditionQuery = fmt.Sprintf(conditionQueryFormat, filterOperator, queryValue)
+
+		return conditionQuery, args, nil
 
-		switch dialect {
-		case DialectMySQL:
-			filterOperator = filterOperatorMap[f.Operator]
-		case DialectPostgres:
-			filterOperator = fmt.Sprintf("i%s", filterOperatorMap[OperatorLike])
-			if f.Operator == OperatorNotLike {
-				filterOperator = fmt.Sprintf("not i%s", filterOperatorMap[OperatorLike])
+	case OperatorBetween, OperatorNotBetween:
+		var (
+			bounds []interface{}
+			start  string
+			end    string
+		)
+
+		if valueRange, ok := f.Value.Value.(Range); ok {
+			bounds = []interface{}{valueRange.Start, valueRange.End}
+		} else {
+			bounds, err = typedSliceToInterfaceSlice(f.Value.Value)
+			if err != nil {
+				err = fmt.Errorf(errForOperatorf, err.Error(), f.Operator)
+				return "", nil, err
 			}
 		}
 
-		conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, queryValue)
+		start, args, err = expressionValueToSQLWithArgs(dialect, args, bounds[0], options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		end, args, err = expressionValueToSQLWithArgs(dialect, args, bounds[1], options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		conditionQueryFormat = "%s %s %s and %s"
+		filterOperator = filterOperatorMap[f.Operator]
+		conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, start, end)
+
+		return conditionQuery, args, nil
+
+	case OperatorStartsWith, OperatorEndsWith, OperatorContains, OperatorLikePattern:
+		var pattern string = reflect.ValueOf(f.Value.Value).String()
+
+		switch f.Operator {
+		case OperatorStartsWith:
+			pattern = fmt.Sprintf("%s%%", escapeLikeValue(pattern))
+		case OperatorEndsWith:
+			pattern = fmt.Sprintf("%%%s", escapeLikeValue(pattern))
+		case OperatorContains:
+			pattern = fmt.Sprintf("%%%s%%", escapeLikeValue(pattern))
+		}
+
+		args = append(args, pattern)
+		placeholderStartIdx = len(args)
+		placeholderEndIdx = len(args)
+		placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
+		conditionQuery, err = f.like(dialect, field, placeholder, false)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if likeEscapeDialect, ok := dialect.(LikeEscapeDialect); ok && f.Operator != OperatorLikePattern {
+			conditionQuery = fmt.Sprintf("%s %s", conditionQuery, likeEscapeDialect.LikeEscape())
+		}
+
+		return conditionQuery, args, nil
+
+	case OperatorLike, OperatorNotLike, OperatorLikeCaseSensitive, OperatorNotLikeCaseSensitive, OperatorLikeCaseInsensitive, OperatorNotLikeCaseInsensitive:
+		queryValue, args, err = f.Value.toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
 
 		if queryValue == "" {
 			placeholderStartIdx = len(args)
 			placeholderEndIdx = len(args)
-			placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
-			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, placeholder)
+			queryValue = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
+		}
+
+		conditionQuery, err = f.like(dialect, field, dialect.Concat("'%'", queryValue, "'%'"), f.Operator == OperatorNotLike || f.Operator == OperatorNotLikeCaseSensitive || f.Operator == OperatorNotLikeCaseInsensitive)
+		if err != nil {
+			return "", nil, err
 		}
 
 		return conditionQuery, args, nil
@@ -238,6 +391,7 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 
 	for i := range f.Filters {
 		var (
+			subFilter         *Filter
 			subConditionQuery string
 			subArgs           []interface{}
 		)
@@ -246,7 +400,15 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 			return "", args, nil
 		}
 
-		subConditionQuery, subArgs, err = f.Filters[i].toSQLWithArgs(dialect, args, false)
+		subFilter = f.Filters[i]
+		if f.CaseSensitivity != "" && subFilter.CaseSensitivity == "" {
+			var inheritedFilter Filter = *subFilter
+
+			inheritedFilter.CaseSensitivity = f.CaseSensitivity
+			subFilter = &inheritedFilter
+		}
+
+		subConditionQuery, subArgs, err = subFilter.toSQLWithArgs(dialect, args, false, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -270,11 +432,41 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 	return whereClause, args, nil
 }
 
-func (f *Filter) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (f *Filter) caseSensitivity() CaseSensitivity {
+	switch f.Operator {
+	case OperatorLikeCaseSensitive, OperatorNotLikeCaseSensitive:
+		return CaseSensitivitySensitive
+
+	case OperatorLikeCaseInsensitive, OperatorNotLikeCaseInsensitive:
+		return CaseSensitivityInsensitive
+	}
+
+	return f.CaseSensitivity
+}
+
+func (f *Filter) like(dialect Dialect, field, pattern string, negate bool) (string, error) {
+	var caseSensitivity CaseSensitivity = f.caseSensitivity()
+
+	if caseSensitivity == "" {
+		return dialect.Like(field, pattern, negate), nil
+	}
+
+	if likeCaseDialect, ok := dialect.(LikeCaseDialect); ok {
+		return likeCaseDialect.LikeCase(field, pattern, negate, caseSensitivity == CaseSensitivitySensitive)
+	}
+
+	return "", fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, caseSensitivity, dialect.Name())
+}
+
+func (f *Filter) toSQLWithArgsWithOptions(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var err error = f.validate(dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return f.toSQLWithArgs(dialect, args, true)
+	return f.toSQLWithArgs(dialect, args, true, options)
+}
+
+func (f *Filter) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgsWithOptions(dialect, args, renderOptions{})
 }
@@ -313,6 +313,28 @@ func TestFilter_SetCondition(t *testing.T) {
 	}
 }
 
+func TestFilter_SetCaseSensitivity(t *testing.T) {
+	var (
+		expectation *Filter
+		actual      *Filter
+	)
+
+	expectation = &Filter{
+		Field:           NewField("field1"),
+		Operator:        OperatorContains,
+		Value:           NewFilterValue("value1"),
+		CaseSensitivity: CaseSensitivitySensitive,
+	}
+
+	actual = NewFilter().
+		SetCondition(NewField("field1"), OperatorContains, NewFilterValue("value1")).
+		SetCaseSensitivity(CaseSensitivitySensitive)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation filter is %+v, got %+v", expectation, actual)
+	}
+}
+
 func TestFilter_AddFilter(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -905,7 +927,7 @@ func TestFilter_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			Filter:      &Filter{},
 			Expectation: ErrDialectIsRequired,
 		},
@@ -1113,6 +1135,171 @@ func TestFilter_validate(t *testing.T) {
 			},
 			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
 		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is not slice, array or range", OperatorBetween),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorBetween, NewFilterValue("value1")),
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorBetween),
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value length is not equal to two", OperatorNotBetween),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorNotBetween, NewFilterValue([]int{1, 2, 3})),
+			Expectation: ErrValueLengthIsNotEqualToTwo,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is select query", OperatorBetween),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorBetween, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("table1")))),
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "select query", OperatorBetween),
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is range", OperatorBetween),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorBetween, NewRangeFilterValue(1, 2)),
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is array", OperatorBetween),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorBetween, NewFilterValue([2]int{1, 2})),
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and field is not nil", OperatorExists),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorExists, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("table1")))),
+			Expectation: ErrFieldIsNotEmpty,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is nil", OperatorNotExists),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(nil, OperatorNotExists, nil),
+			Expectation: ErrValueIsRequired,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is not select query", OperatorExists),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(nil, OperatorExists, NewFilterValue("value1")),
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorExists),
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is select query", OperatorExists),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(nil, OperatorExists, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("table1")))),
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is not string", OperatorStartsWith),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorStartsWith, NewFilterValue(1)),
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Int.String(), OperatorStartsWith),
+		},
+		{
+			Name:        fmt.Sprintf("operator is %s and value is select query", OperatorEndsWith),
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorEndsWith, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("table1")))),
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "select query", OperatorEndsWith),
+		},
+		{
+			Name:        "case sensitivity is unsupported",
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("field1"), OperatorLike, NewFilterValue("value1")).SetCaseSensitivity("mixed"),
+			Expectation: fmt.Errorf(errUnsupportedCaseSensitivityf, "mixed"),
+		},
+		{
+			Name:    "operator is in and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorIn,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", OperatorIn),
+		},
+		{
+			Name:    "operator is between and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorBetween,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", OperatorBetween),
+		},
+		{
+			Name:    "operator is contains and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorContains,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", OperatorContains),
+		},
+		{
+			Name:    "operator is exists and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Operator: OperatorExists,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", OperatorExists),
+		},
+		{
+			Name:    "operator is is null and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorIsNull,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: ErrValueIsNotNil,
+		},
+		{
+			Name:    "operator is greater than and value field is not nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorGreaterThan,
+				Value: &FilterValue{
+					Field: &Field{
+						Column: "field2",
+					},
+				},
+			},
+			Expectation: nil,
+		},
 	}
 
 	for i := range testCases {
@@ -2016,127 +2203,744 @@ func TestFilter_toSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
-	}
-
-	for i := range testCases {
-		t.Run(testCases[i].Name, func(t *testing.T) {
-			var (
-				actualQuery string
-				actualArgs  []interface{}
-				actualErr   error
-			)
-
-			actualQuery, actualArgs, actualErr = testCases[i].Filter.toSQLWithArgs(testCases[i].Dialect, testCases[i].Args, testCases[i].IsRoot)
-
-			if testCases[i].Expectation.Err != nil && actualErr == nil {
-				t.Error("expectation error is not nil, got nil")
-			}
-
-			if testCases[i].Expectation.Err == nil && actualErr != nil {
-				t.Error("expectation error is nil, got not nil")
-			}
-
-			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
-				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
-			}
-
-			if testCases[i].Expectation.Err == nil && actualErr == nil {
-				if testCases[i].Expectation.Query != actualQuery {
-					t.Errorf("expectation conditional query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
-				}
-
-				if len(testCases[i].Expectation.Args) != len(actualArgs) {
-					t.Errorf("expectation args length is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
-				}
-
-				for x := range testCases[i].Expectation.Args {
-					if !deepEqual(testCases[i].Expectation.Args[x], actualArgs[x]) {
-						t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[x], actualArgs[x])
-					}
-				}
-			}
-		})
-	}
-}
-
-func TestFilter_ToSQLWithArgs(t *testing.T) {
-	var testCases []struct {
-		Name        string
-		Filter      *Filter
-		Dialect     Dialect
-		Args        []interface{}
-		Expectation struct {
-			Query string
-			Args  []interface{}
-			Err   error
-		}
-	} = []struct {
-		Name        string
-		Filter      *Filter
-		Dialect     Dialect
-		Args        []interface{}
-		Expectation struct {
-			Query string
-			Args  []interface{}
-			Err   error
-		}
-	}{
 		{
-			Name: "invalid validation",
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLite, OperatorEqual),
 			Filter: &Filter{
-				Logic: LogicAnd,
-				Filters: []*Filter{
-					{
-						Field: &Field{
-							Column: "field1",
-						},
-						Operator: OperatorEqual,
-						Value: &FilterValue{
-							Value: []string{"a", "b", "c"},
-						},
-					},
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: "value1",
 				},
 			},
-			Dialect: DialectMySQL,
+			Dialect: DialectSQLite,
 			Args:    []interface{}{},
+			IsRoot:  false,
 			Expectation: struct {
 				Query string
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "",
-				Args:  []interface{}{},
-				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
+				Query: "field1 = ?",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
 			},
 		},
 		{
-			Name: "filter is valid",
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLite, OperatorIn),
 			Filter: &Filter{
-				Logic: LogicAnd,
-				Filters: []*Filter{
-					{
-						Field: &Field{
-							Column: "field1",
-						},
-						Operator: OperatorEqual,
-						Value: &FilterValue{
-							Value: "value1",
-						},
-					},
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorIn,
+				Value: &FilterValue{
+					Value: []string{"value1", "value2"},
 				},
 			},
-			Dialect: DialectMySQL,
+			Dialect: DialectSQLite,
 			Args:    []interface{}{},
+			IsRoot:  false,
 			Expectation: struct {
 				Query string
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "field1 = ?",
+				Query: "field1 in (?, ?)",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLite, OperatorLike),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorLike,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(field1) like lower('%' || ? || '%')",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLite, OperatorNotLike),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorNotLike,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(field1) not like lower('%' || ? || '%')",
 				Args:  []interface{}{"value1"},
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s and filter value select query is not nil", DialectSQLite, OperatorLike),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorLike,
+				Value: &FilterValue{
+					SelectQuery: &SelectQuery{
+						Fields: []*Field{
+							{
+								Column: "field2",
+							},
+						},
+						Table: &Table{
+							Name: "table2",
+						},
+						Take: 1,
+					},
+				},
+			},
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(field1) like lower('%' || (select field2 from table2 limit ?) || '%')",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLServer, OperatorEqual),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{"value0"},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 = @p2",
+				Args:  []interface{}{"value0", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLServer, OperatorIn),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorIn,
+				Value: &FilterValue{
+					Value: []string{"value1", "value2"},
+				},
+			},
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 in (@p1, @p2)",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLServer, OperatorLike),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorLike,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like concat('%', @p1, '%')",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with filter operator %s", DialectSQLServer, OperatorNotLike),
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorNotLike,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			IsRoot:  false,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 not like concat('%', @p1, '%')",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Filter.toSQLWithArgs(testCases[i].Dialect, testCases[i].Args, testCases[i].IsRoot, renderOptions{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr == nil {
+				if testCases[i].Expectation.Query != actualQuery {
+					t.Errorf("expectation conditional query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+				}
+
+				if len(testCases[i].Expectation.Args) != len(actualArgs) {
+					t.Errorf("expectation args length is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+				}
+
+				for x := range testCases[i].Expectation.Args {
+					if !deepEqual(testCases[i].Expectation.Args[x], actualArgs[x]) {
+						t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[x], actualArgs[x])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestFilter_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Filter      *Filter
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Filter      *Filter
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name: "invalid validation",
+			Filter: &Filter{
+				Logic: LogicAnd,
+				Filters: []*Filter{
+					{
+						Field: &Field{
+							Column: "field1",
+						},
+						Operator: OperatorEqual,
+						Value: &FilterValue{
+							Value: []string{"a", "b", "c"},
+						},
+					},
+				},
+			},
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  []interface{}{},
+				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
+			},
+		},
+		{
+			Name: "filter is valid",
+			Filter: &Filter{
+				Logic: LogicAnd,
+				Filters: []*Filter{
+					{
+						Field: &Field{
+							Column: "field1",
+						},
+						Operator: OperatorEqual,
+						Value: &FilterValue{
+							Value: "value1",
+						},
+					},
+				},
+			},
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 = ?",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and slice value", DialectPostgres, OperatorBetween),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorBetween, NewFilterValue([]string{"2024-01-01", "2024-12-31"})),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{"value0"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 between $2 and $3",
+				Args:  []interface{}{"value0", "2024-01-01", "2024-12-31"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and range value", DialectMySQL, OperatorNotBetween),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorNotBetween, NewRangeFilterValue(1, 10)),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 not between ? and ?",
+				Args:  []interface{}{1, 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with operator %s, range of expressions and other filter", DialectSQLServer, OperatorBetween),
+			Filter: NewFilter().
+				SetLogic(LogicAnd).
+				AddFilter(NewField("field1"), OperatorBetween, NewRangeFilterValue(NewField("field2"), Add(NewField("field2"), 7))).
+				AddFilter(NewField("field3"), OperatorEqual, NewFilterValue("value3")),
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 between field2 and (field2 + @p1) and field3 = @p2",
+				Args:  []interface{}{7, "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with operator %s and select query with bound value on outer alias", DialectPostgres, OperatorExists),
+			Filter: NewFilter().
+				SetLogic(LogicAnd).
+				AddFilter(NewField("u.status"), OperatorEqual, NewFilterValue("active")).
+				AddFilter(nil, OperatorExists, NewSelectQueryFilterValue(
+					Select(NewField("o.id")).
+						From(NewTable("orders").As("o")).
+						Where(
+							NewFilter().
+								SetLogic(LogicAnd).
+								AddFilter(NewField("o.total"), OperatorGreaterThan, NewFilterValue(100)).
+								AddFilter(NewField("u.verified"), OperatorEqual, NewFilterValue(true)),
+						),
+				)),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "u.status = $1 and exists (select o.id from orders as o where o.total > $2 and u.verified = $3)",
+				Args:  []interface{}{"active", 100, true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectMySQL, OperatorNotExists),
+			Filter:  NewFilter().SetCondition(nil, OperatorNotExists, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))))),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "not exists (select field1 from table1 where field2 = ?)",
+				Args:  []interface{}{"value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectPostgres, OperatorStartsWith),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorStartsWith, NewFilterValue("50%_off")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 ilike $1",
+				Args:  []interface{}{`50\%\_off%`},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and case sensitive", DialectPostgres, OperatorStartsWith),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorStartsWith, NewFilterValue("value1")).SetCaseSensitivity(CaseSensitivitySensitive),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like $1",
+				Args:  []interface{}{"value1%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectMySQL, OperatorEndsWith),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorEndsWith, NewFilterValue("value1")),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like ?",
+				Args:  []interface{}{"%value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectSQLite, OperatorContains),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorContains, NewFilterValue(`a\b`)),
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(field1) like lower(?) escape '\\'",
+				Args:  []interface{}{`%a\\b%`},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and case sensitive", DialectSQLite, OperatorContains),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorContains, NewFilterValue("value1")).SetCaseSensitivity(CaseSensitivitySensitive),
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, CaseSensitivitySensitive, DialectSQLite.Name()),
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectSQLServer, OperatorStartsWith),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorStartsWith, NewFilterValue("[a]")),
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like @p1 escape '\\'",
+				Args:  []interface{}{`\[a]%`},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectSQLServer, OperatorLikePattern),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorLikePattern, NewFilterValue("a_%")),
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like @p1",
+				Args:  []interface{}{"a_%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectPostgres, OperatorLikeCaseSensitive),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorLikeCaseSensitive, NewFilterValue("value1")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like concat('%', $1, '%')",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectMySQL, OperatorNotLikeCaseInsensitive),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorNotLikeCaseInsensitive, NewFilterValue("value1")),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(field1) not like lower(concat('%', ?, '%'))",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and case sensitive", DialectMySQL, OperatorStartsWith),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorStartsWith, NewFilterValue("value1")).SetCaseSensitivity(CaseSensitivitySensitive),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like binary ?",
+				Args:  []interface{}{"value1%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s and case sensitive", DialectSQLServer, OperatorContains),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorContains, NewFilterValue("value1")).SetCaseSensitivity(CaseSensitivitySensitive),
+			Dialect: DialectSQLServer,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 like @p1 collate Latin1_General_CS_AS escape '\\'",
+				Args:  []interface{}{"%value1%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with operator %s", DialectSQLite, OperatorLikeCaseSensitive),
+			Filter:  NewFilter().SetCondition(NewField("field1"), OperatorLikeCaseSensitive, NewFilterValue("value1")),
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, CaseSensitivitySensitive, DialectSQLite.Name()),
+			},
+		},
+		{
+			Name: "value field is not nil and operator is greater than",
+			Filter: &Filter{
+				Field: &Field{
+					Table:  "orders",
+					Column: "shipped_at",
+				},
+				Operator: OperatorGreaterThan,
+				Value: &FilterValue{
+					Field: &Field{
+						Table:  "orders",
+						Column: "created_at",
+					},
+				},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "orders.shipped_at > orders.created_at",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "value field is not nil and operator is like",
+			Filter: &Filter{
+				Field: &Field{
+					Table:  "orders",
+					Column: "shipped_at",
+				},
+				Operator: OperatorLike,
+				Value: &FilterValue{
+					Field: &Field{
+						Table:  "orders",
+						Column: "created_at",
+					},
+				},
+			},
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "orders.shipped_at like concat('%', orders.created_at, '%')",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "value field is not nil and operator is in",
+			Filter: &Filter{
+				Field: &Field{
+					Table:  "orders",
+					Column: "shipped_at",
+				},
+				Operator: OperatorIn,
+				Value: &FilterValue{
+					Field: &Field{
+						Table:  "orders",
+						Column: "created_at",
+					},
+				},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, "field", OperatorIn),
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with logic filter case insensitive inherited by filters", DialectPostgres),
+			Filter: NewFilter().
+				SetLogic(LogicAnd).
+				SetCaseSensitivity(CaseSensitivityInsensitive).
+				AddFilter(NewField("field1"), OperatorStartsWith, NewFilterValue("value1")).
+				AddFilter(NewField("field2"), OperatorLike, NewFilterValue("value2")).
+				AddFilters(NewFilter().SetCondition(NewField("field3"), OperatorStartsWith, NewFilterValue("value3")).SetCaseSensitivity(CaseSensitivitySensitive)),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 ilike $1 and field2 ilike concat('%', $2, '%') and field3 like $3",
+				Args:  []interface{}{"value1%", "value2", "value3%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with logic filter case sensitive inherited by nested filters", DialectSQLite),
+			Filter: NewFilter().
+				SetLogic(LogicOr).
+				SetCaseSensitivity(CaseSensitivitySensitive).
+				AddFilters(NewFilter().
+					SetLogic(LogicAnd).
+					AddFilter(NewField("field1"), OperatorContains, NewFilterValue("value1"))),
+			Dialect: DialectSQLite,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedCaseSensitivityForDialectf, CaseSensitivitySensitive, DialectSQLite.Name()),
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -2,9 +2,15 @@ package simple_query
 
 import "fmt"
 
+type Range struct {
+	Start interface{}
+	End   interface{}
+}
+
 type FilterValue struct {
 	Value       interface{}
 	SelectQuery *SelectQuery
+	Field       *Field
 }
 
 func NewFilterValue(value interface{}) *FilterValue {
@@ -13,21 +19,44 @@ func NewFilterValue(value interface{}) *FilterValue {
 	}
 }
 
+func NewRangeFilterValue(start, end interface{}) *FilterValue {
+	return &FilterValue{
+		Value: Range{
+			Start: start,
+			End:   end,
+		},
+	}
+}
+
 func NewSelectQueryFilterValue(selectQuery *SelectQuery) *FilterValue {
 	return &FilterValue{
 		SelectQuery: selectQuery,
 	}
 }
 
+func NewFieldFilterValue(field *Field) *FilterValue {
+	return &FilterValue{
+		Field: field,
+	}
+}
+
 func (v *FilterValue) validate(dialect Dialect) error {
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
+	if v.Field != nil && v.SelectQuery != nil {
+		return ErrConflictFilterValueFieldAndFilterValueSelectQuery
+	}
+
+	if v.Field != nil && v.Value != nil {
+		return ErrConflictFilterValueFieldAndFilterValueValue
+	}
+
 	return nil
 }
 
-func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (v *FilterValue) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		query string
 		err   error
@@ -38,13 +67,17 @@ func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 		return "", nil, err
 	}
 
+	if v.Field != nil {
+		return v.Field.toSQLWithArgs(dialect, args, options)
+	}
+
 	if v.SelectQuery == nil {
 		args = append(args, v.Value)
 
 		return "", args, nil
 	}
 
-	query, args, err = v.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+	query, args, err = v.SelectQuery.toSQLWithArgsWithAlias(dialect, args, options)
 	if err != nil {
 		return "", nil, err
 	}
@@ -53,3 +86,7 @@ func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 
 	return query, args, nil
 }
+
+func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return v.toSQLWithArgs(dialect, args, renderOptions{})
+}
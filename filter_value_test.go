@@ -30,6 +30,18 @@ func testFilterValue_FilterValueEquality(t *testing.T, expectation, actual *Filt
 	if expectation.SelectQuery != nil && actual.SelectQuery != nil && !deepEqual(*expectation.SelectQuery, *actual.SelectQuery) {
 		t.Errorf("expectation select query is %+v, got %+v", expectation.SelectQuery, actual.SelectQuery)
 	}
+
+	if expectation.Field == nil && actual.Field != nil {
+		t.Errorf("expectation field is nil, got %+v", actual.Field)
+	}
+
+	if expectation.Field != nil && actual.Field == nil {
+		t.Errorf("expectation field is %+v, got nil", expectation.Field)
+	}
+
+	if expectation.Field != nil && actual.Field != nil && !deepEqual(*expectation.Field, *actual.Field) {
+		t.Errorf("expectation field is %+v, got %+v", expectation.Field, actual.Field)
+	}
 }
 
 func TestFilterValue_NewFilterValue(t *testing.T) {
@@ -47,6 +59,24 @@ func TestFilterValue_NewFilterValue(t *testing.T) {
 	testFilterValue_FilterValueEquality(t, expectation, actual)
 }
 
+func TestFilterValue_NewRangeFilterValue(t *testing.T) {
+	var (
+		expectation *FilterValue
+		actual      *FilterValue
+	)
+
+	expectation = &FilterValue{
+		Value: Range{
+			Start: "value1",
+			End:   "value2",
+		},
+	}
+
+	actual = NewRangeFilterValue("value1", "value2")
+
+	testFilterValue_FilterValueEquality(t, expectation, actual)
+}
+
 func TestFilterValue_NewSelectQueryFilterValue(t *testing.T) {
 	var (
 		expectation *FilterValue
@@ -74,6 +104,24 @@ func TestFilterValue_NewSelectQueryFilterValue(t *testing.T) {
 	testFilterValue_FilterValueEquality(t, expectation, actual)
 }
 
+func TestFilterValue_NewFieldFilterValue(t *testing.T) {
+	var (
+		expectation *FilterValue
+		actual      *FilterValue
+	)
+
+	expectation = &FilterValue{
+		Field: &Field{
+			Table:  "table1",
+			Column: "field1",
+		},
+	}
+
+	actual = NewFieldFilterValue(NewField("field1").FromTable("table1"))
+
+	testFilterValue_FilterValueEquality(t, expectation, actual)
+}
+
 func TestFilterValue_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -88,10 +136,32 @@ func TestFilterValue_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			FilterValue: &FilterValue{},
 			Expectation: ErrDialectIsRequired,
 		},
+		{
+			Name:    "field is not nil and select query is not nil",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Field: &Field{
+					Column: "field1",
+				},
+				SelectQuery: &SelectQuery{},
+			},
+			Expectation: ErrConflictFilterValueFieldAndFilterValueSelectQuery,
+		},
+		{
+			Name:    "field is not nil and value is not nil",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Field: &Field{
+					Column: "field1",
+				},
+				Value: "value1",
+			},
+			Expectation: ErrConflictFilterValueFieldAndFilterValueValue,
+		},
 		{
 			Name:    "filter value is valid",
 			Dialect: DialectPostgres,
@@ -143,7 +213,7 @@ func TestFilterValue_ToSQLWithArgs(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			FilterValue: &FilterValue{},
 			Expectation: struct {
 				Query string
@@ -212,6 +282,25 @@ func TestFilterValue_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:    "field is not nil",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Field: &Field{
+					Table:  "table1",
+					Column: "field1",
+				},
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "table1.field1",
+				Args:  nil,
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
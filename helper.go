@@ -4,9 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 )
 
+var likeEscapeReplacer *strings.Replacer = strings.NewReplacer(
+	`\`, `\\`,
+	"%", `\%`,
+	"_", `\_`,
+	"[", `\[`,
+)
+
+func escapeLikeValue(value string) string {
+	return likeEscapeReplacer.Replace(value)
+}
+
 func typedSliceToInterfaceSlice(value interface{}) ([]interface{}, error) {
 	var (
 		reflectValue   reflect.Value
@@ -26,35 +38,122 @@ func typedSliceToInterfaceSlice(value interface{}) ([]interface{}, error) {
 	return interfaceSlice, nil
 }
 
-func getPlaceholder(dialect Dialect, startIdx, endIdx int) string {
-	var placeholders []string = []string{}
+func structToColumnsAndValues(value interface{}) ([]string, []interface{}, error) {
+	var (
+		reflectValue reflect.Value
+		columns      []string
+		values       []interface{}
+	)
 
-	if startIdx <= 0 || endIdx <= 0 || endIdx < startIdx {
-		return ""
+	reflectValue = reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return nil, nil, ErrValueIsRequired
+		}
+
+		reflectValue = reflectValue.Elem()
 	}
 
-	switch dialect {
-	case DialectMySQL:
-		if startIdx == endIdx {
-			return placeholderMap[dialect]
-		}
-		for i := startIdx; i <= endIdx; i++ {
-			placeholders = append(placeholders, placeholderMap[dialect])
+	if reflectValue.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf(errUnsupportedValueTypef, reflectValue.Kind().String())
+	}
+
+	columns, values = structValueToColumnsAndValues(reflectValue)
+
+	return columns, values, nil
+}
+
+func structValueToColumnsAndValues(reflectValue reflect.Value) ([]string, []interface{}) {
+	var (
+		columns []string
+		values  []interface{}
+	)
+
+	columns = []string{}
+	values = []interface{}{}
+	for i := 0; i < reflectValue.NumField(); i++ {
+		var (
+			structField reflect.StructField
+			column      string
+		)
+
+		structField = reflectValue.Type().Field(i)
+
+		if structField.Anonymous && structField.Type.Kind() == reflect.Struct {
+			var (
+				embeddedColumns []string
+				embeddedValues  []interface{}
+			)
+
+			embeddedColumns, embeddedValues = structValueToColumnsAndValues(reflectValue.Field(i))
+
+			columns = append(columns, embeddedColumns...)
+			values = append(values, embeddedValues...)
+			continue
 		}
-		return strings.Join(placeholders, ", ")
 
-	case DialectPostgres:
-		if startIdx == endIdx {
-			return fmt.Sprintf("%s%d", placeholderMap[dialect], endIdx)
+		if structField.PkgPath != "" {
+			continue
 		}
-		for i := startIdx; i <= endIdx; i++ {
-			placeholders = append(placeholders, fmt.Sprintf("%s%d", placeholderMap[dialect], i))
+
+		column = strings.Split(structField.Tag.Get("db"), ",")[0]
+		if column == "" || column == "-" {
+			continue
 		}
-		return strings.Join(placeholders, ", ")
 
-	default:
+		columns = append(columns, column)
+		values = append(values, reflectValue.Field(i).Interface())
+	}
+
+	return columns, values
+}
+
+func getPlaceholder(dialect Dialect, startIdx, endIdx int) string {
+	var placeholders []string = []string{}
+
+	if dialect == nil || startIdx <= 0 || endIdx <= 0 || endIdx < startIdx {
 		return ""
 	}
+
+	for i := startIdx; i <= endIdx; i++ {
+		placeholders = append(placeholders, dialect.Placeholder(i))
+	}
+
+	return strings.Join(placeholders, ", ")
+}
+
+func orderedColumns(order []string, columns []string) []string {
+	var (
+		exists    map[string]bool
+		ordered   []string
+		remaining []string
+	)
+
+	exists = map[string]bool{}
+	for i := range columns {
+		exists[columns[i]] = true
+	}
+
+	ordered = []string{}
+	for i := range order {
+		if !exists[order[i]] {
+			continue
+		}
+
+		ordered = append(ordered, order[i])
+		delete(exists, order[i])
+	}
+
+	remaining = []string{}
+	for i := range columns {
+		if exists[columns[i]] {
+			remaining = append(remaining, columns[i])
+		}
+	}
+
+	sort.Strings(remaining)
+
+	return append(ordered, remaining...)
 }
 
 func deepEqual(value1 interface{}, value2 interface{}) bool {
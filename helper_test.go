@@ -82,6 +82,133 @@ func Test_typedSliceToInterfaceSlice(t *testing.T) {
 	}
 }
 
+type testBaseRow struct {
+	ID int64 `db:"id"`
+}
+
+type testRow struct {
+	testBaseRow
+	Field1   string `db:"field1"`
+	Field2   int    `db:"field2,omitempty"`
+	Ignored  string `db:"-"`
+	Untagged string
+	field3   string `db:"field3"`
+}
+
+func Test_structToColumnsAndValues(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Value       interface{}
+		Expectation struct {
+			Columns []string
+			Values  []interface{}
+			Error   error
+		}
+	} = []struct {
+		Name        string
+		Value       interface{}
+		Expectation struct {
+			Columns []string
+			Values  []interface{}
+			Error   error
+		}
+	}{
+		{
+			Name:  "value kind is not struct",
+			Value: "value1",
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Error   error
+			}{
+				Columns: nil,
+				Values:  nil,
+				Error:   fmt.Errorf("unsupported %s value type", reflect.String.String()),
+			},
+		},
+		{
+			Name:  "value is nil pointer",
+			Value: (*testRow)(nil),
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Error   error
+			}{
+				Columns: nil,
+				Values:  nil,
+				Error:   ErrValueIsRequired,
+			},
+		},
+		{
+			Name: "struct to columns and values",
+			Value: testRow{
+				testBaseRow: testBaseRow{ID: 1},
+				Field1:      "value1",
+				Field2:      2,
+				Ignored:     "ignored",
+				Untagged:    "untagged",
+				field3:      "value3",
+			},
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Error   error
+			}{
+				Columns: []string{"id", "field1", "field2"},
+				Values:  []interface{}{int64(1), "value1", 2},
+				Error:   nil,
+			},
+		},
+		{
+			Name: "pointer of struct to columns and values",
+			Value: &testRow{
+				Field1: "value1",
+			},
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Error   error
+			}{
+				Columns: []string{"id", "field1", "field2"},
+				Values:  []interface{}{int64(0), "value1", 0},
+				Error:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualColumns []string
+				actualValues  []interface{}
+				actualErr     error
+			)
+
+			actualColumns, actualValues, actualErr = structToColumnsAndValues(testCases[i].Value)
+
+			if testCases[i].Expectation.Error != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Error == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Error != nil && actualErr != nil && testCases[i].Expectation.Error.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Columns, actualColumns) {
+				t.Errorf("expectation columns is %v, got %v", testCases[i].Expectation.Columns, actualColumns)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Values, actualValues) {
+				t.Errorf("expectation values is %v, got %v", testCases[i].Expectation.Values, actualValues)
+			}
+		})
+	}
+}
+
 func Test_getPlaceholder(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -97,8 +224,8 @@ func Test_getPlaceholder(t *testing.T) {
 		Expectation string
 	}{
 		{
-			Name:        "unknown dialect",
-			Dialect:     "unknown",
+			Name:        "nil dialect",
+			Dialect:     nil,
 			StartIdx:    1,
 			EndIdx:      1,
 			Expectation: "",
@@ -152,6 +279,34 @@ func Test_getPlaceholder(t *testing.T) {
 			EndIdx:      5,
 			Expectation: "$1, $2, $3, $4, $5",
 		},
+		{
+			Name:        "sqlite with start index equal to end index",
+			Dialect:     DialectSQLite,
+			StartIdx:    1,
+			EndIdx:      1,
+			Expectation: "?",
+		},
+		{
+			Name:        "sqlite with start index less than end index",
+			Dialect:     DialectSQLite,
+			StartIdx:    1,
+			EndIdx:      3,
+			Expectation: "?, ?, ?",
+		},
+		{
+			Name:        "sqlserver with start index equal to end index",
+			Dialect:     DialectSQLServer,
+			StartIdx:    1,
+			EndIdx:      1,
+			Expectation: "@p1",
+		},
+		{
+			Name:        "sqlserver with start index less than end index",
+			Dialect:     DialectSQLServer,
+			StartIdx:    1,
+			EndIdx:      3,
+			Expectation: "@p1, @p2, @p3",
+		},
 	}
 
 	for i := range testCases {
@@ -163,3 +318,90 @@ func Test_getPlaceholder(t *testing.T) {
 		})
 	}
 }
+
+func Test_orderedColumns(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Order       []string
+		Columns     []string
+		Expectation []string
+	} = []struct {
+		Name        string
+		Order       []string
+		Columns     []string
+		Expectation []string
+	}{
+		{
+			Name:        "order is empty",
+			Order:       nil,
+			Columns:     []string{"field3", "field1", "field2"},
+			Expectation: []string{"field1", "field2", "field3"},
+		},
+		{
+			Name:        "order covers all columns",
+			Order:       []string{"field3", "field1", "field2"},
+			Columns:     []string{"field1", "field2", "field3"},
+			Expectation: []string{"field3", "field1", "field2"},
+		},
+		{
+			Name:        "order covers some columns",
+			Order:       []string{"field3", "field4"},
+			Columns:     []string{"field2", "field1", "field3"},
+			Expectation: []string{"field3", "field1", "field2"},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string = orderedColumns(testCases[i].Order, testCases[i].Columns)
+
+			if len(testCases[i].Expectation) != len(actual) {
+				t.Fatalf("expected length of columns is %d, got %d", len(testCases[i].Expectation), len(actual))
+			}
+
+			for j := range testCases[i].Expectation {
+				if testCases[i].Expectation[j] != actual[j] {
+					t.Errorf("expected column is %s, got %s", testCases[i].Expectation[j], actual[j])
+				}
+			}
+		})
+	}
+}
+
+func Test_escapeLikeValue(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Value       string
+		Expectation string
+	} = []struct {
+		Name        string
+		Value       string
+		Expectation string
+	}{
+		{
+			Name:        "value without special characters",
+			Value:       "value1",
+			Expectation: "value1",
+		},
+		{
+			Name:        "value with wildcards",
+			Value:       "50%_off",
+			Expectation: `50\%\_off`,
+		},
+		{
+			Name:        "value with escape character and bracket",
+			Value:       `a\b[c]`,
+			Expectation: `a\\b\[c]`,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = escapeLikeValue(testCases[i].Value)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation value is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
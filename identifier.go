@@ -0,0 +1,98 @@
+package simple_query
+
+import "strings"
+
+type renderOptions struct {
+	quoteIdentifiers bool
+	schema           *Schema
+}
+
+func quoteIdentifier(dialect Dialect, identifier string) string {
+	var parts []string
+
+	if dialect == nil {
+		return identifier
+	}
+
+	parts = strings.Split(identifier, ".")
+	for i := range parts {
+		if parts[i] == "*" {
+			continue
+		}
+
+		parts[i] = dialect.QuoteIdentifier(parts[i])
+	}
+
+	return strings.Join(parts, ".")
+}
+
+func (o renderOptions) quote(dialect Dialect, identifier string) string {
+	if !o.quoteIdentifiers || identifier == "" {
+		return identifier
+	}
+
+	return quoteIdentifier(dialect, identifier)
+}
+
+func (o renderOptions) identifier(dialect Dialect, identifier string) (string, error) {
+	var err error
+
+	if o.schema != nil && identifier != "" {
+		err = o.schema.validateIdentifier(identifier)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return o.quote(dialect, identifier), nil
+}
+
+func (o renderOptions) identifiers(dialect Dialect, identifiers []string) ([]string, error) {
+	var quotedIdentifiers []string = []string{}
+
+	for i := range identifiers {
+		var (
+			quotedIdentifier string
+			err              error
+		)
+
+		quotedIdentifier, err = o.identifier(dialect, identifiers[i])
+		if err != nil {
+			return nil, err
+		}
+
+		quotedIdentifiers = append(quotedIdentifiers, quotedIdentifier)
+	}
+
+	return quotedIdentifiers, nil
+}
+
+func (o renderOptions) alias(dialect Dialect, alias string) (string, error) {
+	var err error
+
+	if o.schema != nil && alias != "" {
+		err = o.schema.validateName(alias)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return o.quote(dialect, alias), nil
+}
+
+func (o renderOptions) function(function string) error {
+	if o.schema == nil {
+		return nil
+	}
+
+	return o.schema.validateName(function)
+}
+
+func (o renderOptions) merge(quoteIdentifiers bool, schema *Schema) renderOptions {
+	o.quoteIdentifiers = o.quoteIdentifiers || quoteIdentifiers
+	if schema != nil {
+		o.schema = schema
+	}
+
+	return o
+}
@@ -0,0 +1,134 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIdentifier_quoteIdentifier(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Identifier  string
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Identifier  string
+		Expectation string
+	}{
+		{
+			Name:        "dialect is unsupported",
+			Dialect:     nil,
+			Identifier:  "order",
+			Expectation: "order",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Identifier:  "order",
+			Expectation: "`order`",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Identifier:  "UserName",
+			Expectation: `"UserName"`,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with qualified identifier", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Identifier:  "public.user",
+			Expectation: `"public"."user"`,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with wildcard", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Identifier:  "table1.*",
+			Expectation: "`table1`.*",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with embedded quote", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Identifier:  "field`1",
+			Expectation: "`field``1`",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with embedded quote", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Identifier:  `field"1`,
+			Expectation: `"field""1"`,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Identifier:  "main.order",
+			Expectation: `"main"."order"`,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Identifier:  "dbo.order",
+			Expectation: "[dbo].[order]",
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with embedded quote", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Identifier:  "field]1",
+			Expectation: "[field]]1]",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = quoteIdentifier(testCases[i].Dialect, testCases[i].Identifier)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation identifier is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIdentifier_renderOptionsQuote(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Options     renderOptions
+		Identifier  string
+		Expectation string
+	} = []struct {
+		Name        string
+		Options     renderOptions
+		Identifier  string
+		Expectation string
+	}{
+		{
+			Name:        "quote identifiers is disabled",
+			Options:     renderOptions{},
+			Identifier:  "order",
+			Expectation: "order",
+		},
+		{
+			Name:        "identifier is empty",
+			Options:     renderOptions{quoteIdentifiers: true},
+			Identifier:  "",
+			Expectation: "",
+		},
+		{
+			Name:        "quote identifiers is enabled",
+			Options:     renderOptions{quoteIdentifiers: true},
+			Identifier:  "order",
+			Expectation: `"order"`,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Options.quote(DialectPostgres, testCases[i].Identifier)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation identifier is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -2,13 +2,27 @@ package simple_query
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 )
 
 type InsertQuery struct {
-	Table        string
-	FieldsValues map[string][]interface{}
+	CommonTableExpressions []*CommonTableExpression
+	Table                  string
+	Fields                 []string
+	FieldsValues           map[string][]interface{}
+	SelectQuery            *SelectQuery
+	Conflict               *OnConflict
+	ReturningFields        []*Field
+
+	QuotedIdentifiers bool
+	Schema            *Schema
+
+	err error
+}
+
+type InsertBatch struct {
+	Query string
+	Args  []interface{}
 }
 
 func Insert() *InsertQuery {
@@ -17,16 +31,194 @@ func Insert() *InsertQuery {
 	}
 }
 
+func (i *InsertQuery) With(name string, selectQuery *SelectQuery) *InsertQuery {
+	i.CommonTableExpressions = append(i.CommonTableExpressions, NewCommonTableExpression(name, selectQuery))
+	return i
+}
+
+func (i *InsertQuery) WithRecursive(name string, anchor, recursive *SelectQuery) *InsertQuery {
+	i.CommonTableExpressions = append(i.CommonTableExpressions, NewRecursiveCommonTableExpression(name, anchor, recursive))
+	return i
+}
+
 func (i *InsertQuery) Into(table string) *InsertQuery {
 	i.Table = table
 	return i
 }
 
 func (i *InsertQuery) Value(field string, value interface{}) *InsertQuery {
+	if _, ok := i.FieldsValues[field]; !ok {
+		i.Fields = append(i.Fields, field)
+	}
+
 	i.FieldsValues[field] = append(i.FieldsValues[field], value)
 	return i
 }
 
+func (i *InsertQuery) Values(row map[string]interface{}) *InsertQuery {
+	var (
+		columns []string
+		values  []interface{}
+	)
+
+	columns = []string{}
+	for column := range row {
+		columns = append(columns, column)
+	}
+
+	columns = orderedColumns(i.Fields, columns)
+
+	values = []interface{}{}
+	for columnIndex := range columns {
+		values = append(values, row[columns[columnIndex]])
+	}
+
+	return i.appendRow(columns, values)
+}
+
+func (i *InsertQuery) Rows(columns []string, rows ...[]interface{}) *InsertQuery {
+	for rowIndex := range rows {
+		i.appendRow(columns, rows[rowIndex])
+	}
+
+	return i
+}
+
+func (i *InsertQuery) FromStructs(slice interface{}) *InsertQuery {
+	var (
+		rows []interface{}
+		err  error
+	)
+
+	rows, err = typedSliceToInterfaceSlice(slice)
+	if err != nil {
+		i.err = err
+		return i
+	}
+
+	for rowIndex := range rows {
+		var (
+			columns []string
+			values  []interface{}
+		)
+
+		columns, values, err = structToColumnsAndValues(rows[rowIndex])
+		if err != nil {
+			i.err = err
+			return i
+		}
+
+		i.appendRow(columns, values)
+	}
+
+	return i
+}
+
+func (i *InsertQuery) appendRow(columns []string, values []interface{}) *InsertQuery {
+	var (
+		rowColumns map[string]bool
+		rowCount   int
+	)
+
+	if i.err != nil {
+		return i
+	}
+
+	if len(columns) != len(values) {
+		i.err = ErrValueLengthIsNotEqualToFieldsLength
+		return i
+	}
+
+	rowColumns = map[string]bool{}
+	for columnIndex := range columns {
+		if rowColumns[columns[columnIndex]] {
+			i.err = ErrRowColumnsIsNotEqualToFieldsColumns
+			return i
+		}
+
+		rowColumns[columns[columnIndex]] = true
+	}
+
+	if len(i.FieldsValues) > 0 && len(rowColumns) != len(i.FieldsValues) {
+		i.err = ErrRowColumnsIsNotEqualToFieldsColumns
+		return i
+	}
+
+	rowCount = -1
+	for field, fieldValues := range i.FieldsValues {
+		if !rowColumns[field] {
+			i.err = ErrRowColumnsIsNotEqualToFieldsColumns
+			return i
+		}
+
+		if rowCount >= 0 && rowCount != len(fieldValues) {
+			i.err = ErrValueLengthIsNotEqualToFieldsLength
+			return i
+		}
+
+		rowCount = len(fieldValues)
+	}
+
+	for columnIndex := range columns {
+		i.Value(columns[columnIndex], values[columnIndex])
+	}
+
+	return i
+}
+
+func (i *InsertQuery) FromSelect(selectQuery *SelectQuery, columns ...string) *InsertQuery {
+	i.Fields = columns
+	i.SelectQuery = selectQuery
+	return i
+}
+
+func (i *InsertQuery) OnConflict(columns ...string) *InsertQuery {
+	i.Conflict = NewOnConflict(columns...)
+	return i
+}
+
+func (i *InsertQuery) DoNothing() *InsertQuery {
+	if i.Conflict == nil {
+		i.Conflict = NewOnConflict()
+	}
+
+	i.Conflict.DoNothing()
+	return i
+}
+
+func (i *InsertQuery) DoUpdateSet(field string, value interface{}) *InsertQuery {
+	if i.Conflict == nil {
+		i.Conflict = NewOnConflict()
+	}
+
+	i.Conflict.DoUpdateSet(field, value)
+	return i
+}
+
+func (i *InsertQuery) DoUpdateWhere(filter *Filter) *InsertQuery {
+	if i.Conflict == nil {
+		i.Conflict = NewOnConflict()
+	}
+
+	i.Conflict.Where(filter)
+	return i
+}
+
+func (i *InsertQuery) Returning(fields ...*Field) *InsertQuery {
+	i.ReturningFields = fields
+	return i
+}
+
+func (i *InsertQuery) QuoteIdentifiers() *InsertQuery {
+	i.QuotedIdentifiers = true
+	return i
+}
+
+func (i *InsertQuery) WithSchema(schema *Schema) *InsertQuery {
+	i.Schema = schema
+	return i
+}
+
 func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 	var (
 		columns    []string
@@ -42,9 +234,7 @@ func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 		}
 	}
 
-	sort.Slice(columns, func(i, j int) bool {
-		return columns[i] < columns[j]
-	})
+	columns = orderedColumns(i.Fields, columns)
 
 	rowsValues = [][]interface{}{}
 	for rowIndex := 0; rowIndex < rowCount; rowIndex++ {
@@ -70,7 +260,7 @@ func (i *InsertQuery) validate(dialect Dialect) error {
 		rowsValues [][]interface{}
 	)
 
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
@@ -78,6 +268,18 @@ func (i *InsertQuery) validate(dialect Dialect) error {
 		return ErrTableIsRequired
 	}
 
+	if i.err != nil {
+		return i.err
+	}
+
+	if i.SelectQuery != nil {
+		return i.validateSelect(dialect)
+	}
+
+	if len(i.CommonTableExpressions) > 0 && insertCommonTableExpressionPlacement(dialect) == CommonTableExpressionPlacementSelect {
+		return fmt.Errorf(errUnsupportedCommonTableExpressionForDialectf, dialect.Name())
+	}
+
 	columns, rowsValues = i.getColumnsAndRowsValues()
 
 	if len(columns) == 0 {
@@ -102,27 +304,46 @@ func (i *InsertQuery) validate(dialect Dialect) error {
 		}
 	}
 
-	return nil
+	return validateReturning(dialect, i.ReturningFields)
 }
 
-func (i *InsertQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+func (i *InsertQuery) validateSelect(dialect Dialect) error {
+	if len(i.FieldsValues) > 0 {
+		return ErrConflictInsertValuesAndInsertSelectQuery
+	}
+
+	if len(i.Fields) == 0 {
+		return ErrFieldsIsRequired
+	}
+
+	for columnIndex := 0; columnIndex < len(i.Fields); columnIndex++ {
+		if i.Fields[columnIndex] == "" {
+			return ErrFieldIsRequired
+		}
+	}
+
+	if len(i.SelectQuery.resultFields()) != len(i.Fields) {
+		return ErrSelectFieldsLengthIsNotEqualToFieldsLength
+	}
+
+	return validateReturning(dialect, i.ReturningFields)
+}
+
+func (i *InsertQuery) valuesToSQLWithArgs(dialect Dialect, table string, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
-		columns      []string
-		rowsValues   [][]interface{}
-		query        string
-		args         []interface{}
-		placeholders []string
-		err          error
+		columns       []string
+		rowsValues    [][]interface{}
+		placeholders  []string
+		quotedColumns []string
+		err           error
 	)
 
-	err = i.validate(dialect)
+	columns, rowsValues = i.getColumnsAndRowsValues()
+	quotedColumns, err = options.identifiers(dialect, columns)
 	if err != nil {
 		return "", nil, err
 	}
 
-	columns, rowsValues = i.getColumnsAndRowsValues()
-	args = []interface{}{}
-
 	for rowIndex := 0; rowIndex < len(rowsValues); rowIndex++ {
 		var (
 			placeholderStartIdx int
@@ -137,7 +358,224 @@ func (i *InsertQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		placeholders = append(placeholders, placeholder)
 	}
 
-	query = fmt.Sprintf("insert into %s(%s) values %s", i.Table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	return fmt.Sprintf("insert into %s(%s) values %s", table, strings.Join(quotedColumns, ", "), strings.Join(placeholders, ", ")), args, nil
+}
+
+func (i *InsertQuery) selectToSQLWithArgs(dialect Dialect, table string, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		quotedColumns []string
+		withClause    string
+		selectClause  string
+		err           error
+	)
+
+	quotedColumns, err = options.identifiers(dialect, i.Fields)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if insertCommonTableExpressionPlacement(dialect) == CommonTableExpressionPlacementSelect {
+		withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, i.CommonTableExpressions, options)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	selectClause, args, err = i.SelectQuery.toSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if withClause != "" {
+		selectClause = fmt.Sprintf("%s %s", withClause, selectClause)
+	}
+
+	return fmt.Sprintf("insert into %s(%s) %s", table, strings.Join(quotedColumns, ", "), selectClause), args, nil
+}
+
+func (i *InsertQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+	var (
+		columns    []string
+		query      string
+		args       []interface{}
+		withClause string
+		table      string
+		options    renderOptions
+		err        error
+	)
+
+	err = i.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	options = renderOptions{quoteIdentifiers: i.QuotedIdentifiers, schema: i.Schema}
+	table, err = options.identifier(dialect, i.Table)
+	if err != nil {
+		return "", nil, err
+	}
+
+	args = []interface{}{}
+
+	if insertCommonTableExpressionPlacement(dialect) != CommonTableExpressionPlacementSelect {
+		withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, i.CommonTableExpressions, options)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if i.SelectQuery != nil {
+		columns = i.Fields
+		query, args, err = i.selectToSQLWithArgs(dialect, table, args, options)
+	} else {
+		columns, _ = i.getColumnsAndRowsValues()
+		query, args, err = i.valuesToSQLWithArgs(dialect, table, args, options)
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if withClause != "" {
+		query = fmt.Sprintf("%s %s", withClause, query)
+	}
+
+	if i.Conflict != nil {
+		var conflictClause string
+
+		conflictClause, args, err = i.Conflict.toSQLWithArgs(dialect, args, columns, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s %s", query, conflictClause)
+	}
+
+	if len(i.ReturningFields) > 0 {
+		var returningClause string
+
+		returningClause, args, err = returningToSQLWithArgs(dialect, args, i.ReturningFields, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s %s", query, returningClause)
+	}
 
 	return query, args, nil
 }
+
+func (i *InsertQuery) batchRowCount(dialect Dialect, limit BatchLimit, columns []string, rowsValues [][]interface{}) (int, error) {
+	var (
+		batch            InsertQuery
+		args             []interface{}
+		rowCount         int
+		parameterLimited int
+		err              error
+	)
+
+	if batchLimitDialect, ok := dialect.(BatchLimitDialect); ok {
+		if limit.MaxParameters == 0 {
+			limit.MaxParameters = batchLimitDialect.BatchLimit().MaxParameters
+		}
+
+		if limit.MaxRows == 0 {
+			limit.MaxRows = batchLimitDialect.BatchLimit().MaxRows
+		}
+	}
+
+	rowCount = len(rowsValues)
+
+	if limit.MaxRows > 0 && limit.MaxRows < rowCount {
+		rowCount = limit.MaxRows
+	}
+
+	if limit.MaxParameters > 0 {
+		batch = i.batch(columns, rowsValues[:1])
+		_, args, err = batch.ToSQLWithArgs(dialect)
+		if err != nil {
+			return 0, err
+		}
+
+		parameterLimited = (limit.MaxParameters - (len(args) - len(columns))) / len(columns)
+		if parameterLimited < 1 {
+			return 0, ErrBatchLimitIsTooSmall
+		}
+
+		if parameterLimited < rowCount {
+			rowCount = parameterLimited
+		}
+	}
+
+	return rowCount, nil
+}
+
+func (i *InsertQuery) batch(columns []string, rowsValues [][]interface{}) InsertQuery {
+	var batch InsertQuery = *i
+
+	batch.FieldsValues = map[string][]interface{}{}
+	for rowIndex := range rowsValues {
+		for columnIndex := range columns {
+			batch.FieldsValues[columns[columnIndex]] = append(batch.FieldsValues[columns[columnIndex]], rowsValues[rowIndex][columnIndex])
+		}
+	}
+
+	return batch
+}
+
+func (i *InsertQuery) ToBatchesSQLWithArgs(dialect Dialect, limit BatchLimit) ([]*InsertBatch, error) {
+	var (
+		columns    []string
+		rowsValues [][]interface{}
+		rowCount   int
+		batches    []*InsertBatch
+		err        error
+	)
+
+	err = i.validate(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.SelectQuery != nil {
+		var batch *InsertBatch = &InsertBatch{}
+
+		batch.Query, batch.Args, err = i.ToSQLWithArgs(dialect)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*InsertBatch{batch}, nil
+	}
+
+	columns, rowsValues = i.getColumnsAndRowsValues()
+
+	rowCount, err = i.batchRowCount(dialect, limit, columns, rowsValues)
+	if err != nil {
+		return nil, err
+	}
+
+	batches = []*InsertBatch{}
+	for rowStartIdx := 0; rowStartIdx < len(rowsValues); rowStartIdx += rowCount {
+		var (
+			rowEndIdx   int
+			insertQuery InsertQuery
+			batch       *InsertBatch = &InsertBatch{}
+		)
+
+		rowEndIdx = rowStartIdx + rowCount
+		if rowEndIdx > len(rowsValues) {
+			rowEndIdx = len(rowsValues)
+		}
+
+		insertQuery = i.batch(columns, rowsValues[rowStartIdx:rowEndIdx])
+		batch.Query, batch.Args, err = insertQuery.ToSQLWithArgs(dialect)
+		if err != nil {
+			return nil, err
+		}
+
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
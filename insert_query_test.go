@@ -22,6 +22,16 @@ func testInsertQuery_InsertQueryEquality(t *testing.T, expectation, actual *Inse
 		t.Errorf("expectation table is %s, got %s", expectation.Table, actual.Table)
 	}
 
+	if len(expectation.Fields) != len(actual.Fields) {
+		t.Errorf("expectation length of fields is %d, got %d", len(expectation.Fields), len(actual.Fields))
+	}
+
+	for i := range expectation.Fields {
+		if i < len(actual.Fields) && expectation.Fields[i] != actual.Fields[i] {
+			t.Errorf("expectation field is %s, got %s", expectation.Fields[i], actual.Fields[i])
+		}
+	}
+
 	if len(expectation.FieldsValues) != len(actual.FieldsValues) {
 		t.Errorf("expectation length of field values is %d, got %d", len(expectation.FieldsValues), len(actual.FieldsValues))
 	}
@@ -75,6 +85,7 @@ func TestInsertQuery_Value(t *testing.T) {
 	)
 
 	expectation = &InsertQuery{
+		Fields: []string{"field3", "field1", "field2"},
 		FieldsValues: map[string][]interface{}{
 			"field1": {"value1", "value2", "value3"},
 			"field2": {1, 2, 3},
@@ -82,19 +93,179 @@ func TestInsertQuery_Value(t *testing.T) {
 		},
 	}
 	actual = Insert().
+		Value("field3", true).
 		Value("field1", "value1").
 		Value("field2", 1).
-		Value("field3", true).
+		Value("field3", false).
 		Value("field1", "value2").
 		Value("field2", 2).
-		Value("field3", false).
+		Value("field3", true).
 		Value("field1", "value3").
-		Value("field2", 3).
-		Value("field3", true)
+		Value("field2", 3)
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_Values(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		Fields: []string{"field1", "field2"},
+		FieldsValues: map[string][]interface{}{
+			"field1": {"value1", "value2"},
+			"field2": {1, 2},
+		},
+	}
+	actual = Insert().
+		Values(map[string]interface{}{"field2": 1, "field1": "value1"}).
+		Values(map[string]interface{}{"field1": "value2", "field2": 2})
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_Rows(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		Fields: []string{"field2", "field1"},
+		FieldsValues: map[string][]interface{}{
+			"field1": {"value1", "value2"},
+			"field2": {1, 2},
+		},
+	}
+	actual = Insert().
+		Rows(
+			[]string{"field2", "field1"},
+			[]interface{}{1, "value1"},
+			[]interface{}{2, "value2"},
+		)
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_FromStructs(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		Fields: []string{"id", "field1", "field2"},
+		FieldsValues: map[string][]interface{}{
+			"id":     {int64(1), int64(2)},
+			"field1": {"value1", "value2"},
+			"field2": {1, 2},
+		},
+	}
+	actual = Insert().
+		FromStructs([]*testRow{
+			{testBaseRow: testBaseRow{ID: 1}, Field1: "value1", Field2: 1},
+			{testBaseRow: testBaseRow{ID: 2}, Field1: "value2", Field2: 2},
+		})
 
 	testInsertQuery_InsertQueryEquality(t, expectation, actual)
 }
 
+func TestInsertQuery_FromSelect(t *testing.T) {
+	var (
+		selectQuery *SelectQuery
+		actual      *InsertQuery
+	)
+
+	selectQuery = Select(NewField("field1"), NewField("field2")).
+		From(NewTable("table2"))
+	actual = Insert().
+		Into("table1").
+		FromSelect(selectQuery, "field1", "field2")
+
+	testInsertQuery_InsertQueryEquality(
+		t,
+		&InsertQuery{
+			Table:        "table1",
+			Fields:       []string{"field1", "field2"},
+			FieldsValues: map[string][]interface{}{},
+		},
+		actual,
+	)
+
+	if actual.SelectQuery != selectQuery {
+		t.Errorf("expectation select query is %+v, got %+v", selectQuery, actual.SelectQuery)
+	}
+}
+
+func TestInsertQuery_OnConflict(t *testing.T) {
+	var actual *InsertQuery = Insert().
+		Into("table1").
+		Value("field1", "value1").
+		OnConflict("field1").
+		DoUpdateSet("field2", NewExcludedValue("field2")).
+		DoUpdateWhere(NewFilter().SetCondition(NewField("field3"), OperatorEqual, NewFilterValue("value3")))
+
+	testOnConflict_OnConflictEquality(
+		t,
+		&OnConflict{
+			Columns: []string{"field1"},
+			Action:  ConflictActionDoUpdate,
+			Fields:  []string{"field2"},
+			FieldsValue: map[string]interface{}{
+				"field2": &ExcludedValue{Column: "field2"},
+			},
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field3",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: "value3",
+				},
+			},
+		},
+		actual.Conflict,
+	)
+}
+
+func TestInsertQuery_DoNothing(t *testing.T) {
+	var actual *InsertQuery = Insert().
+		Into("table1").
+		Value("field1", "value1").
+		DoNothing()
+
+	testOnConflict_OnConflictEquality(
+		t,
+		&OnConflict{
+			Action:      ConflictActionDoNothing,
+			FieldsValue: map[string]interface{}{},
+		},
+		actual.Conflict,
+	)
+}
+
+func TestInsertQuery_QuoteIdentifiers(t *testing.T) {
+	var actual *InsertQuery = Insert().
+		Into("table1").
+		QuoteIdentifiers()
+
+	if !actual.QuotedIdentifiers {
+		t.Error("expectation quoted identifiers is true, got false")
+	}
+}
+
+func TestInsertQuery_WithSchema(t *testing.T) {
+	var actual *InsertQuery = Insert().
+		Into("table1").
+		WithSchema(NewSchema("table1"))
+
+	if actual.Schema == nil {
+		t.Error("expectation schema is not nil, got nil")
+	}
+}
+
 func TestInsertQuery_getColumnsAndRowsValues(t *testing.T) {
 	var testCases []struct {
 		Name                 string
@@ -125,6 +296,23 @@ func TestInsertQuery_getColumnsAndRowsValues(t *testing.T) {
 				{"value4"},
 			},
 		},
+		{
+			Name: "columns in value order",
+			InsertQuery: &InsertQuery{
+				Table:  "table1",
+				Fields: []string{"field3", "field1", "field2"},
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1", "value2"},
+					"field2": {1, 2},
+					"field3": {true, false},
+				},
+			},
+			ExpectationColumns: []string{"field3", "field1", "field2"},
+			ExpectationRowValues: [][]interface{}{
+				{true, "value1", 1},
+				{false, "value2", 2},
+			},
+		},
 		{
 			Name: "insert query is valid",
 			InsertQuery: &InsertQuery{
@@ -198,7 +386,7 @@ func TestInsertQuery_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			InsertQuery: &InsertQuery{},
 			Expectation: ErrDialectIsRequired,
 		},
@@ -251,6 +439,157 @@ func TestInsertQuery_validate(t *testing.T) {
 			},
 			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
 		},
+		{
+			Name:    "select with values",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:  "table1",
+				Fields: []string{"field1"},
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1"},
+				},
+				SelectQuery: Select(NewField("field1")).From(NewTable("table2")),
+			},
+			Expectation: ErrConflictInsertValuesAndInsertSelectQuery,
+		},
+		{
+			Name:    "select without fields",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:       "table1",
+				SelectQuery: Select(NewField("field1")).From(NewTable("table2")),
+			},
+			Expectation: ErrFieldsIsRequired,
+		},
+		{
+			Name:    "select with empty field",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:       "table1",
+				Fields:      []string{""},
+				SelectQuery: Select(NewField("field1")).From(NewTable("table2")),
+			},
+			Expectation: ErrFieldIsRequired,
+		},
+		{
+			Name:    "select fields length is not equal to fields length",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:       "table1",
+				Fields:      []string{"field1", "field2"},
+				SelectQuery: Select(NewField("field1")).From(NewTable("table2")),
+			},
+			Expectation: ErrSelectFieldsLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:    "insert select query is valid",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:       "table1",
+				Fields:      []string{"field1"},
+				SelectQuery: Select(NewField("field1")).From(NewTable("table2")),
+			},
+			Expectation: nil,
+		},
+		{
+			Name:    "row length is not equal to columns length",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"field1", "field2"}, []interface{}{"value1", 1}, []interface{}{"value2", 2, true}),
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:    "structs is not slice",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				FromStructs(testRow{}),
+			Expectation: fmt.Errorf(errUnsupportedValueTypef, "struct"),
+		},
+		{
+			Name:    "structs element is not struct",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				FromStructs([]string{"value1"}),
+			Expectation: fmt.Errorf(errUnsupportedValueTypef, "string"),
+		},
+		{
+			Name:    "values with missing column",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Values(map[string]interface{}{"field1": "value1", "field2": 1}).
+				Values(map[string]interface{}{"field1": "value2"}),
+			Expectation: ErrRowColumnsIsNotEqualToFieldsColumns,
+		},
+		{
+			Name:    "values with extra column",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Values(map[string]interface{}{"field1": "value1"}).
+				Values(map[string]interface{}{"field1": "value2", "field2": 2}),
+			Expectation: ErrRowColumnsIsNotEqualToFieldsColumns,
+		},
+		{
+			Name:    "values with disjoint columns",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Values(map[string]interface{}{"a": 1}).
+				Values(map[string]interface{}{"b": 2}).
+				Values(map[string]interface{}{"a": 3, "b": 4}),
+			Expectation: ErrRowColumnsIsNotEqualToFieldsColumns,
+		},
+		{
+			Name:    "rows with disjoint columns",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"a"}, []interface{}{1}).
+				Rows([]string{"b"}, []interface{}{2}),
+			Expectation: ErrRowColumnsIsNotEqualToFieldsColumns,
+		},
+		{
+			Name:    "rows with duplicate column",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"a", "a"}, []interface{}{1, 2}),
+			Expectation: ErrRowColumnsIsNotEqualToFieldsColumns,
+		},
+		{
+			Name:    "values after value with different column lengths",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Value("a", 1).
+				Value("a", 2).
+				Value("b", 3).
+				Values(map[string]interface{}{"a": 4, "b": 5}),
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:    "values after value with same column lengths",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Value("a", 1).
+				Value("b", 2).
+				Values(map[string]interface{}{"a": 3, "b": 4}),
+			Expectation: nil,
+		},
+		{
+			Name:    "value after values with different column lengths",
+			Dialect: DialectPostgres,
+			InsertQuery: Insert().
+				Into("table1").
+				Values(map[string]interface{}{"a": 1, "b": 2}).
+				Value("a", 3),
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
 		{
 			Name:    "insert query is valid",
 			Dialect: DialectPostgres,
@@ -358,43 +697,757 @@ func TestInsertQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
-	}
-
-	for i := range testCases {
-		t.Run(testCases[i].Name, func(t *testing.T) {
-			var (
-				actualQuery string
-				actualArgs  []interface{}
-				actualErr   error
-			)
-
-			actualQuery, actualArgs, actualErr = testCases[i].InsertQuery.ToSQLWithArgs(testCases[i].Dialect)
-
-			if testCases[i].Expectation.Err != nil && actualErr == nil {
-				t.Error("expectation error is not nil, got nil")
-			}
-
-			if testCases[i].Expectation.Err == nil && actualErr != nil {
-				t.Error("expectation error is nil, got not nil")
-			}
-
-			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
-				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
-			}
-
-			if testCases[i].Expectation.Err == nil && actualErr == nil {
-				if testCases[i].Expectation.Query != actualQuery {
-					t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
-				}
-
-				if len(testCases[i].Expectation.Args) != len(actualArgs) {
-					t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
-				}
-
-				for j := range testCases[i].Expectation.Args {
-					if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
-						t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[j], actualArgs[j])
-					}
+		{
+			Name: fmt.Sprintf("insert query with dialect %s in value order", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field2", 1).
+				Value("field1", "value1").
+				Value("field2", 2).
+				Value("field1", "value2"),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field2, field1) values ($1, $2), ($3, $4)",
+				Args:  []interface{}{1, "value1", 2, "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and on conflict do update", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				Value("field2", 2).
+				OnConflict("field1").
+				DoUpdateSet("field2", NewExcludedValue("field2")).
+				DoUpdateSet("field3", "value3").
+				DoUpdateWhere(NewFilter().SetCondition(NewField("field4").FromTable("table1"), OperatorLessThan, NewFilterValue(4))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) values ($1, $2) on conflict(field1) do update set field2 = excluded.field2, field3 = $3 where table1.field4 < $4",
+				Args:  []interface{}{"value1", 2, "value3", 4},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and on conflict do update", DialectMySQL),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				Value("field2", 2).
+				DoUpdateSet("field2", NewExcludedValue("field2")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) values (?, ?) on duplicate key update field2 = values(field2)",
+				Args:  []interface{}{"value1", 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and on conflict do nothing", DialectSQLite),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				OnConflict("field1").
+				DoNothing(),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) values (?) on conflict(field1) do nothing",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and on conflict", DialectSQLServer),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				OnConflict("field1").
+				DoNothing(),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedOnConflictForDialectf, DialectSQLServer.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s, on conflict and returning", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				OnConflict("field1").
+				DoNothing().
+				Returning(NewField("id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) values ($1) on conflict(field1) do nothing returning id",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and returning", DialectMySQL),
+			InsertQuery: Insert().
+				Into("table1").
+				Value("field1", "value1").
+				Returning(NewField("id")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedReturningForDialectf, DialectMySQL.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select query with dialect %s", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(
+					Select(NewField("field1"), Coalesce(NewField("field2"), "value2")).
+						From(NewTable("table2")).
+						Where(NewFilter().SetCondition(NewField("field3"), OperatorEqual, NewFilterValue("value3"))),
+					"field1",
+					"field2",
+				).
+				OnConflict("field1").
+				DoUpdateSet("field2", "value4").
+				Returning(NewField("id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) select field1, coalesce(field2, $1) from table2 where field3 = $2 on conflict(field1) do update set field2 = $3 returning id",
+				Args:  []interface{}{"value2", "value3", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select query with dialect %s and quoted identifiers", DialectMySQL),
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(
+					Select(NewField("field1")).
+						From(NewTable("table2")).
+						Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+					"field1",
+				).
+				QuoteIdentifiers(),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into `table1`(`field1`) select `field1` from `table2` where `field2` = ?",
+				Args:  []interface{}{"value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "insert select query is invalid",
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(Select(NewField("field1")), "field1"),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name: "insert select query field is not allowed",
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(Select(NewField("field1")).From(NewTable("table2")), "field9").
+				WithSchema(NewSchema("table1", "table2", "field1")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field9", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s from structs", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				FromStructs([]testRow{
+					{testBaseRow: testBaseRow{ID: 1}, Field1: "value1", Field2: 1},
+					{testBaseRow: testBaseRow{ID: 2}, Field1: "value2", Field2: 2},
+				}),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(id, field1, field2) values ($1, $2, $3), ($4, $5, $6)",
+				Args:  []interface{}{int64(1), "value1", 1, int64(2), "value2", 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s from rows", DialectMySQL),
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"field2", "field1"}, []interface{}{1, "value1"}, []interface{}{2, "value2"}),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field2, field1) values (?, ?), (?, ?)",
+				Args:  []interface{}{1, "value1", 2, "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and quoted identifiers", DialectMySQL),
+			InsertQuery: &InsertQuery{
+				Table: "order",
+				FieldsValues: map[string][]interface{}{
+					"group": {"value1"},
+					"key":   {1},
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into `order`(`group`, `key`) values (?, ?)",
+				Args:  []interface{}{"value1", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and schema and table is not allowed", DialectPostgres),
+			InsertQuery: &InsertQuery{
+				Table: "table2",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1"},
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "table2", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s and schema and column is not allowed", DialectPostgres),
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1) values (1); --": {"value1"},
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field1) values (1); --", Err: ErrIdentifierIsInvalid},
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s", DialectSQLite),
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1", "value2"},
+					"field2": {1, 2},
+				},
+			},
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) values (?, ?), (?, ?)",
+				Args:  []interface{}{"value1", 1, "value2", 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert query with dialect %s", DialectSQLServer),
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1", "value2"},
+					"field2": {1, 2},
+				},
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) values (@p1, @p2), (@p3, @p4)",
+				Args:  []interface{}{"value1", 1, "value2", 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select with dialect %s and common table expression", DialectPostgres),
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Into("table1").
+				FromSelect(Select(NewField("field1")).From(NewTable("cte1")).Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))), "field1"),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = $1) insert into table1(field1) select field1 from cte1 where field1 = $2",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select with dialect %s and common table expression", DialectMySQL),
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Into("table1").
+				FromSelect(Select(NewField("field1")).From(NewTable("cte1")).Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))), "field1"),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) with cte1 as (select field1 from table2 where field2 = ?) select field1 from cte1 where field1 = ?",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert values with dialect %s and common table expression", DialectSQLite),
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Into("table1").
+				Value("field1", "value1"),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = ?) insert into table1(field1) values (?)",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert values with dialect %s and common table expression", DialectMySQL),
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Into("table1").
+				Value("field1", "value1"),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedCommonTableExpressionForDialectf, DialectMySQL.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select with dialect %s and union", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(
+					Union(
+						Select(NewField("id")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))),
+						Select(NewField("id")).From(NewTable("table3")),
+					),
+					"id",
+				),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(id) select id from table2 where field1 = $1 union select id from table3",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select with dialect %s and union with mismatched fields", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(
+					Union(
+						Select(NewField("id"), NewField("field1")).From(NewTable("table2")),
+						Select(NewField("id"), NewField("field1")).From(NewTable("table3")),
+					),
+					"id",
+				),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSelectFieldsLengthIsNotEqualToFieldsLength,
+			},
+		},
+		{
+			Name: "insert select with custom dialect mariadb and common table expression",
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2"))).
+				Into("table1").
+				FromSelect(Select(NewField("field1")).From(NewTable("cte1")), "field1"),
+			Dialect: mariaDBDialect{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) with cte1 as (select field1 from table2) select field1 from cte1",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "insert values with custom dialect mariadb and common table expression",
+			InsertQuery: Insert().
+				With("cte1", Select(NewField("field1")).From(NewTable("table2"))).
+				Into("table1").
+				Value("field1", "value1"),
+			Dialect: mariaDBDialect{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedCommonTableExpressionForDialectf, "mariadb"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].InsertQuery.ToSQLWithArgs(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr == nil {
+				if testCases[i].Expectation.Query != actualQuery {
+					t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+				}
+
+				if len(testCases[i].Expectation.Args) != len(actualArgs) {
+					t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+				}
+
+				for j := range testCases[i].Expectation.Args {
+					if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+						t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[j], actualArgs[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestInsertQuery_ToBatchesSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Dialect     Dialect
+		Limit       BatchLimit
+		Expectation struct {
+			Batches []*InsertBatch
+			Err     error
+		}
+	} = []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Dialect     Dialect
+		Limit       BatchLimit
+		Expectation struct {
+			Batches []*InsertBatch
+			Err     error
+		}
+	}{
+		{
+			Name:        "insert query is invalid",
+			InsertQuery: Insert(),
+			Dialect:     DialectPostgres,
+			Limit:       BatchLimit{},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: nil,
+				Err:     ErrTableIsRequired,
+			},
+		},
+		{
+			Name: "batch limit is too small",
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"field1", "field2"}, []interface{}{"value1", 1}),
+			Dialect: DialectPostgres,
+			Limit:   BatchLimit{MaxParameters: 1},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: nil,
+				Err:     ErrBatchLimitIsTooSmall,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with default batch limit", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Rows([]string{"field1", "field2"}, []interface{}{"value1", 1}, []interface{}{"value2", 2}),
+			Dialect: DialectPostgres,
+			Limit:   BatchLimit{},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: []*InsertBatch{
+					{
+						Query: "insert into table1(field1, field2) values ($1, $2), ($3, $4)",
+						Args:  []interface{}{"value1", 1, "value2", 2},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with max rows", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Rows(
+					[]string{"field1", "field2"},
+					[]interface{}{"value1", 1},
+					[]interface{}{"value2", 2},
+					[]interface{}{"value3", 3},
+				),
+			Dialect: DialectPostgres,
+			Limit:   BatchLimit{MaxRows: 2},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: []*InsertBatch{
+					{
+						Query: "insert into table1(field1, field2) values ($1, $2), ($3, $4)",
+						Args:  []interface{}{"value1", 1, "value2", 2},
+					},
+					{
+						Query: "insert into table1(field1, field2) values ($1, $2)",
+						Args:  []interface{}{"value3", 3},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with max parameters and on conflict", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				Rows(
+					[]string{"field1", "field2"},
+					[]interface{}{"value1", 1},
+					[]interface{}{"value2", 2},
+					[]interface{}{"value3", 3},
+				).
+				OnConflict("field1").
+				DoUpdateSet("field2", "value4"),
+			Dialect: DialectPostgres,
+			Limit:   BatchLimit{MaxParameters: 5},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: []*InsertBatch{
+					{
+						Query: "insert into table1(field1, field2) values ($1, $2), ($3, $4) on conflict(field1) do update set field2 = $5",
+						Args:  []interface{}{"value1", 1, "value2", 2, "value4"},
+					},
+					{
+						Query: "insert into table1(field1, field2) values ($1, $2) on conflict(field1) do update set field2 = $3",
+						Args:  []interface{}{"value3", 3, "value4"},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with max parameters", DialectMySQL),
+			InsertQuery: Insert().
+				Into("table1").
+				Rows(
+					[]string{"field1"},
+					[]interface{}{"value1"},
+					[]interface{}{"value2"},
+					[]interface{}{"value3"},
+				),
+			Dialect: DialectMySQL,
+			Limit:   BatchLimit{MaxParameters: 2},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: []*InsertBatch{
+					{
+						Query: "insert into table1(field1) values (?), (?)",
+						Args:  []interface{}{"value1", "value2"},
+					},
+					{
+						Query: "insert into table1(field1) values (?)",
+						Args:  []interface{}{"value3"},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert select query with dialect %s", DialectPostgres),
+			InsertQuery: Insert().
+				Into("table1").
+				FromSelect(
+					Select(NewField("field1")).
+						From(NewTable("table2")).
+						Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+					"field1",
+				),
+			Dialect: DialectPostgres,
+			Limit:   BatchLimit{MaxRows: 1},
+			Expectation: struct {
+				Batches []*InsertBatch
+				Err     error
+			}{
+				Batches: []*InsertBatch{
+					{
+						Query: "insert into table1(field1) select field1 from table2 where field2 = $1",
+						Args:  []interface{}{"value2"},
+					},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualBatches []*InsertBatch
+				actualErr     error
+			)
+
+			actualBatches, actualErr = testCases[i].InsertQuery.ToBatchesSQLWithArgs(testCases[i].Dialect, testCases[i].Limit)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if len(testCases[i].Expectation.Batches) != len(actualBatches) {
+				t.Fatalf("expectation length of batches is %d, got %d", len(testCases[i].Expectation.Batches), len(actualBatches))
+			}
+
+			for j := range testCases[i].Expectation.Batches {
+				if testCases[i].Expectation.Batches[j].Query != actualBatches[j].Query {
+					t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Batches[j].Query, actualBatches[j].Query)
+				}
+
+				if !deepEqual(testCases[i].Expectation.Batches[j].Args, actualBatches[j].Args) {
+					t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Batches[j].Args, actualBatches[j].Args)
 				}
 			}
 		})
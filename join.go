@@ -0,0 +1,188 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Join struct {
+	Type   JoinType
+	Table  *Table
+	Filter *Filter
+}
+
+func NewJoin(joinType JoinType, table *Table, filter *Filter) *Join {
+	return &Join{
+		Type:   joinType,
+		Table:  table,
+		Filter: filter,
+	}
+}
+
+func (j *Join) validate(dialect Dialect) error {
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	if j.Type == "" {
+		return ErrJoinTypeIsRequired
+	}
+
+	if _, ok := joinTypeMap[j.Type]; !ok {
+		return fmt.Errorf(errUnsupportedJoinTypef, j.Type)
+	}
+
+	if joinTypeDialect, ok := dialect.(JoinTypeDialect); ok && !joinTypeDialect.SupportsJoinType(j.Type) {
+		return fmt.Errorf(errUnsupportedJoinTypeForDialectf, j.Type, dialect.Name())
+	}
+
+	if j.Table == nil {
+		return ErrTableIsRequired
+	}
+
+	if j.Type == JoinTypeCross && j.Filter != nil {
+		return ErrFilterIsNotNil
+	}
+
+	if j.Type != JoinTypeCross && j.Filter == nil {
+		return ErrFilterIsRequired
+	}
+
+	return nil
+}
+
+func (j *Join) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		table    string
+		onClause string
+		query    string
+		err      error
+	)
+
+	err = j.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	table, args, err = j.Table.toSQLWithArgsWithAlias(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s %s", joinTypeMap[j.Type], table)
+
+	if j.Filter != nil {
+		onClause, args, err = j.Filter.toSQLWithArgsWithOptions(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if onClause != "" {
+			query = fmt.Sprintf("%s on %s", query, onClause)
+		}
+	}
+
+	return query, args, nil
+}
+
+func (j *Join) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return j.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func updateJoinStyle(dialect Dialect) UpdateJoinStyle {
+	if updateJoinDialect, ok := dialect.(UpdateJoinDialect); ok {
+		return updateJoinDialect.UpdateJoinStyle()
+	}
+
+	return ""
+}
+
+func deleteJoinStyle(dialect Dialect) DeleteJoinStyle {
+	if deleteJoinDialect, ok := dialect.(DeleteJoinDialect); ok {
+		return deleteJoinDialect.DeleteJoinStyle()
+	}
+
+	return ""
+}
+
+func joinsToSQLWithArgs(dialect Dialect, args []interface{}, joins []*Join, options renderOptions) (string, []interface{}, error) {
+	var (
+		queries []string
+		err     error
+	)
+
+	queries = []string{}
+	for i := range joins {
+		var query string
+
+		if joins[i] == nil {
+			return "", nil, ErrJoinIsNil
+		}
+
+		query, args, err = joins[i].toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	return strings.Join(queries, " "), args, nil
+}
+
+func joinsToFromSQLWithArgs(dialect Dialect, args []interface{}, joins []*Join, options renderOptions) (string, *Filter, []interface{}, error) {
+	var (
+		from  string
+		query string
+		err   error
+	)
+
+	if joins[0] == nil {
+		return "", nil, nil, ErrJoinIsNil
+	}
+
+	err = joins[0].validate(dialect)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if joins[0].Type != JoinTypeInner && joins[0].Type != JoinTypeCross {
+		return "", nil, nil, fmt.Errorf(errUnsupportedJoinTypeForDialectf, joins[0].Type, dialect.Name())
+	}
+
+	from, args, err = joins[0].Table.toSQLWithArgsWithAlias(dialect, args, options)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if len(joins) > 1 {
+		query, args, err = joinsToSQLWithArgs(dialect, args, joins[1:], options)
+		if err != nil {
+			return "", nil, nil, err
+		}
+
+		from = fmt.Sprintf("%s %s", from, query)
+	}
+
+	return from, joins[0].Filter, args, nil
+}
+
+func combineFilters(filters ...*Filter) *Filter {
+	var combinedFilter *Filter = NewFilter().SetLogic(LogicAnd)
+
+	for i := range filters {
+		if filters[i] != nil {
+			combinedFilter.Filters = append(combinedFilter.Filters, filters[i])
+		}
+	}
+
+	if len(combinedFilter.Filters) == 0 {
+		return nil
+	}
+
+	if len(combinedFilter.Filters) == 1 {
+		return combinedFilter.Filters[0]
+	}
+
+	return combinedFilter
+}
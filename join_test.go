@@ -0,0 +1,442 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testJoin_JoinEquality(t *testing.T, expectation, actual *Join) {
+	if expectation == nil && actual == nil {
+		t.Skip("expectation and actual is nil")
+	}
+
+	if expectation == nil && actual != nil {
+		t.Errorf("expectation is nil, got %+v", actual)
+	}
+
+	if expectation != nil && actual == nil {
+		t.Errorf("expectation is %+v, got nil", expectation)
+	}
+
+	if expectation.Type != actual.Type {
+		t.Errorf("expectation type is %s, got %s", expectation.Type, actual.Type)
+	}
+
+	if !deepEqual(expectation.Table, actual.Table) {
+		t.Errorf("expectation table is %+v, got %+v", expectation.Table, actual.Table)
+	}
+
+	if !deepEqual(expectation.Filter, actual.Filter) {
+		t.Errorf("expectation filter is %+v, got %+v", expectation.Filter, actual.Filter)
+	}
+}
+
+func TestJoin_NewJoin(t *testing.T) {
+	testJoin_JoinEquality(
+		t,
+		&Join{
+			Type: JoinTypeLeft,
+			Table: &Table{
+				Name: "table2",
+			},
+			Filter: &Filter{
+				Field: &Field{
+					Table:  "table2",
+					Column: "field1",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: "value1",
+				},
+			},
+		},
+		NewJoin(
+			JoinTypeLeft,
+			NewTable("table2"),
+			NewFilter().
+				SetCondition(NewField("field1").FromTable("table2"), OperatorEqual, NewFilterValue("value1")),
+		),
+	)
+}
+
+func TestJoin_validate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Join        *Join
+		Dialect     Dialect
+		Expectation error
+	} = []struct {
+		Name        string
+		Join        *Join
+		Dialect     Dialect
+		Expectation error
+	}{
+		{
+			Name:        "dialect is empty",
+			Join:        &Join{},
+			Dialect:     nil,
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        "type is empty",
+			Join:        &Join{},
+			Dialect:     DialectPostgres,
+			Expectation: ErrJoinTypeIsRequired,
+		},
+		{
+			Name: "type is unsupported",
+			Join: &Join{
+				Type: "outer",
+			},
+			Dialect:     DialectPostgres,
+			Expectation: fmt.Errorf(errUnsupportedJoinTypef, "outer"),
+		},
+		{
+			Name: fmt.Sprintf("type is full with dialect %s", mariaDBDialect{}.Name()),
+			Join: &Join{
+				Type: JoinTypeFull,
+			},
+			Dialect:     mariaDBDialect{},
+			Expectation: fmt.Errorf(errUnsupportedJoinTypeForDialectf, JoinTypeFull, mariaDBDialect{}.Name()),
+		},
+		{
+			Name: "table is nil",
+			Join: &Join{
+				Type: JoinTypeInner,
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrTableIsRequired,
+		},
+		{
+			Name: "type is cross and filter is not nil",
+			Join: &Join{
+				Type: JoinTypeCross,
+				Table: &Table{
+					Name: "table2",
+				},
+				Filter: &Filter{},
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrFilterIsNotNil,
+		},
+		{
+			Name: "type is not cross and filter is nil",
+			Join: &Join{
+				Type: JoinTypeInner,
+				Table: &Table{
+					Name: "table2",
+				},
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrFilterIsRequired,
+		},
+		{
+			Name: "join is valid",
+			Join: &Join{
+				Type: JoinTypeCross,
+				Table: &Table{
+					Name: "table2",
+				},
+			},
+			Dialect:     DialectPostgres,
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].Join.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestJoin_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Join        *Join
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Join        *Join
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "join is invalid",
+			Join:    &Join{},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinTypeIsRequired,
+			},
+		},
+		{
+			Name: "table to sql with args with alias is error",
+			Join: &Join{
+				Type:  JoinTypeCross,
+				Table: &Table{},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrNameIsRequired,
+			},
+		},
+		{
+			Name: "filter to sql with args is error",
+			Join: &Join{
+				Type: JoinTypeInner,
+				Table: &Table{
+					Name: "table2",
+				},
+				Filter: &Filter{},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name: "cross join",
+			Join: &Join{
+				Type: JoinTypeCross,
+				Table: &Table{
+					Name:  "table2",
+					Alias: "t2",
+				},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cross join table2 as t2",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with inner join", DialectMySQL),
+			Join: &Join{
+				Type: JoinTypeInner,
+				Table: &Table{
+					Name: "table2",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Table:  "table2",
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+			},
+			Dialect: DialectMySQL,
+			Args:    []interface{}{"value0"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "inner join table2 on table2.field1 = ?",
+				Args:  []interface{}{"value0", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with left join select query table", DialectPostgres),
+			Join: &Join{
+				Type: JoinTypeLeft,
+				Table: &Table{
+					SelectQuery: &SelectQuery{
+						Fields: []*Field{
+							{
+								Column: "field1",
+							},
+						},
+						Table: &Table{
+							Name: "table2",
+						},
+						Filter: &Filter{
+							Field: &Field{
+								Column: "field2",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value2",
+							},
+						},
+					},
+					Alias: "alias2",
+				},
+				Filter: &Filter{
+					Logic: LogicAnd,
+					Filters: []*Filter{
+						{
+							Field: &Field{
+								Table:  "alias2",
+								Column: "field1",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value1",
+							},
+						},
+						{
+							Field: &Field{
+								Table:  "alias2",
+								Column: "field3",
+							},
+							Operator: OperatorIsNotNull,
+						},
+					},
+				},
+			},
+			Dialect: DialectPostgres,
+			Args:    []interface{}{"value0"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "left join (select field1 from table2 where field2 = $2) as alias2 on alias2.field1 = $3 and alias2.field3 is not null",
+				Args:  []interface{}{"value0", "value2", "value1"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Join.ToSQLWithArgs(testCases[i].Dialect, testCases[i].Args)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
+
+func TestJoin_combineFilters(t *testing.T) {
+	var (
+		filter1   *Filter
+		filter2   *Filter
+		testCases []struct {
+			Name        string
+			Filters     []*Filter
+			Expectation *Filter
+		}
+	)
+
+	filter1 = NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))
+	filter2 = NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))
+
+	testCases = []struct {
+		Name        string
+		Filters     []*Filter
+		Expectation *Filter
+	}{
+		{
+			Name:        "filters is nil",
+			Filters:     []*Filter{nil, nil},
+			Expectation: nil,
+		},
+		{
+			Name:        "single filter",
+			Filters:     []*Filter{nil, filter1},
+			Expectation: filter1,
+		},
+		{
+			Name:    "multiple filters",
+			Filters: []*Filter{filter1, filter2},
+			Expectation: &Filter{
+				Logic:   LogicAnd,
+				Filters: []*Filter{filter1, filter2},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *Filter = combineFilters(testCases[i].Filters...)
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation filter is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ExcludedValue struct {
+	Column string
+}
+
+func NewExcludedValue(column string) *ExcludedValue {
+	return &ExcludedValue{
+		Column: column,
+	}
+}
+
+func upsertStyle(dialect Dialect) UpsertStyle {
+	if upsertDialect, ok := dialect.(UpsertDialect); ok {
+		return upsertDialect.UpsertStyle()
+	}
+
+	return ""
+}
+
+func (e *ExcludedValue) toSQL(dialect Dialect, options renderOptions) (string, error) {
+	var (
+		column string
+		err    error
+	)
+
+	if e.Column == "" {
+		return "", ErrColumnIsRequired
+	}
+
+	column, err = options.identifier(dialect, e.Column)
+	if err != nil {
+		return "", err
+	}
+
+	if upsertStyle(dialect) == UpsertStyleOnDuplicateKey {
+		return fmt.Sprintf("values(%s)", column), nil
+	}
+
+	return fmt.Sprintf("excluded.%s", column), nil
+}
+
+type OnConflict struct {
+	Columns     []string
+	Action      ConflictAction
+	Fields      []string
+	FieldsValue map[string]interface{}
+	Filter      *Filter
+}
+
+func NewOnConflict(columns ...string) *OnConflict {
+	return &OnConflict{
+		Columns:     columns,
+		FieldsValue: map[string]interface{}{},
+	}
+}
+
+func (o *OnConflict) DoNothing() *OnConflict {
+	o.Action = ConflictActionDoNothing
+	return o
+}
+
+func (o *OnConflict) DoUpdateSet(field string, value interface{}) *OnConflict {
+	if o.FieldsValue == nil {
+		o.FieldsValue = map[string]interface{}{}
+	}
+
+	if _, ok := o.FieldsValue[field]; !ok {
+		o.Fields = append(o.Fields, field)
+	}
+
+	o.Action = ConflictActionDoUpdate
+	o.FieldsValue[field] = value
+	return o
+}
+
+func (o *OnConflict) Where(filter *Filter) *OnConflict {
+	o.Filter = filter
+	return o
+}
+
+func (o *OnConflict) getColumns() []string {
+	var columns []string = []string{}
+
+	for field := range o.FieldsValue {
+		columns = append(columns, field)
+	}
+
+	return orderedColumns(o.Fields, columns)
+}
+
+func (o *OnConflict) validate(dialect Dialect) error {
+	var style UpsertStyle
+
+	if dialect == nil {
+		return ErrDialectIsRequired
+	}
+
+	style = upsertStyle(dialect)
+	if style != UpsertStyleOnConflict && style != UpsertStyleOnDuplicateKey {
+		return fmt.Errorf(errUnsupportedOnConflictForDialectf, dialect.Name())
+	}
+
+	for i := range o.Columns {
+		if o.Columns[i] == "" {
+			return ErrColumnIsRequired
+		}
+	}
+
+	switch o.Action {
+	case "":
+		return ErrConflictActionIsRequired
+
+	case ConflictActionDoNothing:
+		if o.Filter != nil {
+			return ErrFilterIsNotNil
+		}
+
+	case ConflictActionDoUpdate:
+		if style != UpsertStyleOnDuplicateKey && len(o.Columns) == 0 {
+			return ErrConflictColumnsIsRequired
+		}
+
+		if len(o.FieldsValue) == 0 {
+			return ErrFieldsIsRequired
+		}
+
+		for field := range o.FieldsValue {
+			if field == "" {
+				return ErrFieldIsRequired
+			}
+		}
+
+		if style == UpsertStyleOnDuplicateKey && o.Filter != nil {
+			return fmt.Errorf(errUnsupportedOnConflictFilterForDialectf, dialect.Name())
+		}
+
+	default:
+		return fmt.Errorf(errUnsupportedConflictActionf, o.Action)
+	}
+
+	return nil
+}
+
+func (o *OnConflict) setToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		columns []string
+		sets    []string
+		err     error
+	)
+
+	columns = o.getColumns()
+	sets = []string{}
+
+	for i := range columns {
+		var (
+			column string
+			value  string
+		)
+
+		column, err = options.identifier(dialect, columns[i])
+		if err != nil {
+			return "", nil, err
+		}
+
+		value, args, err = setValueToSQLWithArgs(dialect, args, o.FieldsValue[columns[i]], options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sets = append(sets, fmt.Sprintf("%s = %s", column, value))
+	}
+
+	return strings.Join(sets, ", "), args, nil
+}
+
+func (o *OnConflict) toSQLWithArgs(dialect Dialect, args []interface{}, insertColumns []string, options renderOptions) (string, []interface{}, error) {
+	var (
+		query       string
+		columns     []string
+		sets        string
+		whereClause string
+		err         error
+	)
+
+	err = o.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if upsertStyle(dialect) == UpsertStyleOnDuplicateKey {
+		if o.Action == ConflictActionDoNothing {
+			var column string
+
+			if len(insertColumns) == 0 {
+				return "", nil, ErrFieldsIsRequired
+			}
+
+			column, err = options.identifier(dialect, insertColumns[0])
+			if err != nil {
+				return "", nil, err
+			}
+
+			return fmt.Sprintf("on duplicate key update %s = %s", column, column), args, nil
+		}
+
+		sets, args, err = o.setToSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("on duplicate key update %s", sets), args, nil
+	}
+
+	query = "on conflict"
+	if len(o.Columns) > 0 {
+		columns, err = options.identifiers(dialect, o.Columns)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s(%s)", query, strings.Join(columns, ", "))
+	}
+
+	if o.Action == ConflictActionDoNothing {
+		return fmt.Sprintf("%s do nothing", query), args, nil
+	}
+
+	sets, args, err = o.setToSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s do update set %s", query, sets)
+
+	if o.Filter != nil {
+		whereClause, args, err = o.Filter.toSQLWithArgsWithOptions(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if whereClause != "" {
+			query = fmt.Sprintf("%s where %s", query, whereClause)
+		}
+	}
+
+	return query, args, nil
+}
@@ -0,0 +1,479 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testOnConflict_OnConflictEquality(t *testing.T, expectation, actual *OnConflict) {
+	if expectation == nil && actual == nil {
+		t.Skip("expectation and actual is nil")
+	}
+
+	if expectation == nil && actual != nil {
+		t.Errorf("expectation is nil, got %+v", actual)
+	}
+
+	if expectation != nil && actual == nil {
+		t.Errorf("expectation is %+v, got nil", expectation)
+	}
+
+	if !deepEqual(expectation.Columns, actual.Columns) {
+		t.Errorf("expectation columns is %v, got %v", expectation.Columns, actual.Columns)
+	}
+
+	if expectation.Action != actual.Action {
+		t.Errorf("expectation action is %s, got %s", expectation.Action, actual.Action)
+	}
+
+	if !deepEqual(expectation.Fields, actual.Fields) {
+		t.Errorf("expectation fields is %v, got %v", expectation.Fields, actual.Fields)
+	}
+
+	if !deepEqual(expectation.FieldsValue, actual.FieldsValue) {
+		t.Errorf("expectation fields value is %v, got %v", expectation.FieldsValue, actual.FieldsValue)
+	}
+
+	if !deepEqual(expectation.Filter, actual.Filter) {
+		t.Errorf("expectation filter is %+v, got %+v", expectation.Filter, actual.Filter)
+	}
+}
+
+func TestOnConflict_NewOnConflict(t *testing.T) {
+	testOnConflict_OnConflictEquality(
+		t,
+		&OnConflict{
+			Columns:     []string{"field1", "field2"},
+			FieldsValue: map[string]interface{}{},
+		},
+		NewOnConflict("field1", "field2"),
+	)
+}
+
+func TestOnConflict_DoNothing(t *testing.T) {
+	testOnConflict_OnConflictEquality(
+		t,
+		&OnConflict{
+			Columns:     []string{"field1"},
+			Action:      ConflictActionDoNothing,
+			FieldsValue: map[string]interface{}{},
+		},
+		NewOnConflict("field1").DoNothing(),
+	)
+}
+
+func TestOnConflict_DoUpdateSet(t *testing.T) {
+	testOnConflict_OnConflictEquality(
+		t,
+		&OnConflict{
+			Columns: []string{"field1"},
+			Action:  ConflictActionDoUpdate,
+			Fields:  []string{"field3", "field2"},
+			FieldsValue: map[string]interface{}{
+				"field2": &ExcludedValue{Column: "field2"},
+				"field3": "value3",
+			},
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field4",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: "value4",
+				},
+			},
+		},
+		NewOnConflict("field1").
+			DoUpdateSet("field3", "value3").
+			DoUpdateSet("field2", NewExcludedValue("field2")).
+			Where(NewFilter().SetCondition(NewField("field4"), OperatorEqual, NewFilterValue("value4"))),
+	)
+}
+
+func TestOnConflict_validate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		OnConflict  *OnConflict
+		Dialect     Dialect
+		Expectation error
+	} = []struct {
+		Name        string
+		OnConflict  *OnConflict
+		Dialect     Dialect
+		Expectation error
+	}{
+		{
+			Name:        "dialect is empty",
+			OnConflict:  NewOnConflict("field1").DoNothing(),
+			Dialect:     nil,
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s is unsupported", DialectSQLServer),
+			OnConflict:  NewOnConflict("field1").DoNothing(),
+			Dialect:     DialectSQLServer,
+			Expectation: fmt.Errorf(errUnsupportedOnConflictForDialectf, DialectSQLServer.Name()),
+		},
+		{
+			Name:        "column is empty",
+			OnConflict:  NewOnConflict("").DoNothing(),
+			Dialect:     DialectPostgres,
+			Expectation: ErrColumnIsRequired,
+		},
+		{
+			Name:        "action is empty",
+			OnConflict:  NewOnConflict("field1"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictActionIsRequired,
+		},
+		{
+			Name: "action is unsupported",
+			OnConflict: &OnConflict{
+				Columns: []string{"field1"},
+				Action:  "do_something",
+			},
+			Dialect:     DialectPostgres,
+			Expectation: fmt.Errorf(errUnsupportedConflictActionf, "do_something"),
+		},
+		{
+			Name: "do nothing with filter",
+			OnConflict: NewOnConflict("field1").
+				DoNothing().
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			Dialect:     DialectPostgres,
+			Expectation: ErrFilterIsNotNil,
+		},
+		{
+			Name:        fmt.Sprintf("do update with dialect %s and without columns", DialectPostgres),
+			OnConflict:  NewOnConflict().DoUpdateSet("field2", "value2"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictColumnsIsRequired,
+		},
+		{
+			Name: "do update without fields",
+			OnConflict: &OnConflict{
+				Columns: []string{"field1"},
+				Action:  ConflictActionDoUpdate,
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldsIsRequired,
+		},
+		{
+			Name:        "do update with empty field",
+			OnConflict:  NewOnConflict("field1").DoUpdateSet("", "value2"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldIsRequired,
+		},
+		{
+			Name: fmt.Sprintf("do update with dialect %s and filter", DialectMySQL),
+			OnConflict: NewOnConflict().
+				DoUpdateSet("field2", "value2").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			Dialect:     DialectMySQL,
+			Expectation: fmt.Errorf(errUnsupportedOnConflictFilterForDialectf, DialectMySQL.Name()),
+		},
+		{
+			Name:        fmt.Sprintf("do update with dialect %s and without columns", DialectMySQL),
+			OnConflict:  NewOnConflict().DoUpdateSet("field2", "value2"),
+			Dialect:     DialectMySQL,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("do nothing with dialect %s and without columns", DialectSQLite),
+			OnConflict:  NewOnConflict().DoNothing(),
+			Dialect:     DialectSQLite,
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].OnConflict.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestOnConflict_toSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		OnConflict    *OnConflict
+		Dialect       Dialect
+		InsertColumns []string
+		Options       renderOptions
+		Expectation   struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name          string
+		OnConflict    *OnConflict
+		Dialect       Dialect
+		InsertColumns []string
+		Options       renderOptions
+		Expectation   struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:          "on conflict is invalid",
+			OnConflict:    NewOnConflict("field1"),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictActionIsRequired,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("do nothing with dialect %s", DialectPostgres),
+			OnConflict:    NewOnConflict("field1").DoNothing(),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on conflict(field1) do nothing",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("do nothing with dialect %s and without columns", DialectSQLite),
+			OnConflict:    NewOnConflict().DoNothing(),
+			Dialect:       DialectSQLite,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on conflict do nothing",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("do update with dialect %s, excluded value and filter", DialectPostgres),
+			OnConflict: NewOnConflict("field1", "field2").
+				DoUpdateSet("field3", NewExcludedValue("field3")).
+				DoUpdateSet("field4", "value4").
+				Where(NewFilter().SetCondition(NewField("field5"), OperatorEqual, NewFilterValue("value5"))),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Options:       renderOptions{quoteIdentifiers: true},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `on conflict("field1", "field2") do update set "field3" = excluded."field3", "field4" = $3 where "field5" = $4`,
+				Args:  []interface{}{"value1", "value2", "value4", "value5"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("do update with dialect %s and field value", DialectSQLite),
+			OnConflict: NewOnConflict("field1").
+				DoUpdateSet("field2", Coalesce(NewField("field2").FromTable("excluded"), NewField("field2"), "value3")),
+			Dialect:       DialectSQLite,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on conflict(field1) do update set field2 = coalesce(excluded.field2, field2, ?)",
+				Args:  []interface{}{"value1", "value2", "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("do nothing with dialect %s", DialectMySQL),
+			OnConflict:    NewOnConflict("field1").DoNothing(),
+			Dialect:       DialectMySQL,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on duplicate key update field1 = field1",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("do update with dialect %s and excluded value", DialectMySQL),
+			OnConflict: NewOnConflict().
+				DoUpdateSet("field2", NewExcludedValue("field2")).
+				DoUpdateSet("field3", "value3"),
+			Dialect:       DialectMySQL,
+			InsertColumns: []string{"field1", "field2"},
+			Options:       renderOptions{quoteIdentifiers: true},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on duplicate key update `field2` = values(`field2`), `field3` = ?",
+				Args:  []interface{}{"value1", "value2", "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "do update with custom dialect mariadb and excluded value",
+			OnConflict: NewOnConflict().
+				DoUpdateSet("field2", NewExcludedValue("field2")),
+			Dialect:       mariaDBDialect{},
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on duplicate key update field2 = values(field2)",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "do update with custom dialect mariadb and filter",
+			OnConflict: NewOnConflict().
+				DoUpdateSet("field2", NewExcludedValue("field2")).
+				Where(NewFilter().SetCondition(NewField("field3"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect:       mariaDBDialect{},
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedOnConflictFilterForDialectf, "mariadb"),
+			},
+		},
+		{
+			Name:          "do nothing with custom dialect oracle",
+			OnConflict:    NewOnConflict("field1").DoNothing(),
+			Dialect:       oracleDialect{},
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on conflict(field1) do nothing",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("do update with dialect %s and expression value", DialectPostgres),
+			OnConflict: NewOnConflict("field1").
+				DoUpdateSet("field2", Add(NewField("field2").FromTable("table1"), NewExcludedValue("field2"))),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "on conflict(field1) do update set field2 = table1.field2 + excluded.field2",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:          "excluded value column is empty",
+			OnConflict:    NewOnConflict("field1").DoUpdateSet("field2", NewExcludedValue("")),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:          "conflict column is not allowed",
+			OnConflict:    NewOnConflict("field9").DoNothing(),
+			Dialect:       DialectPostgres,
+			InsertColumns: []string{"field1", "field2"},
+			Options:       renderOptions{schema: NewSchema("field1", "field2")},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field9", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].OnConflict.toSQLWithArgs(testCases[i].Dialect, []interface{}{"value1", "value2"}, testCases[i].InsertColumns, testCases[i].Options)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Fatalf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+)
+
+func orderByLimitStyle(dialect Dialect) OrderByLimitStyle {
+	if orderByLimitDialect, ok := dialect.(OrderByLimitDialect); ok {
+		return orderByLimitDialect.OrderByLimitStyle()
+	}
+
+	return ""
+}
+
+func validateOrderByLimit(dialect Dialect, joins []*Join, sorts []*Sort, take uint64) error {
+	var style OrderByLimitStyle
+
+	if len(sorts) == 0 && take == 0 {
+		return nil
+	}
+
+	style = orderByLimitStyle(dialect)
+	if style != OrderByLimitStyleClause && style != OrderByLimitStyleCTID {
+		return fmt.Errorf(errUnsupportedOrderByLimitForDialectf, dialect.Name())
+	}
+
+	if len(joins) > 0 {
+		return ErrConflictJoinsAndOrderByOrLimit
+	}
+
+	return nil
+}
+
+func orderByLimitToSQLWithArgs(dialect Dialect, args []interface{}, sorts []*Sort, take uint64, options renderOptions) (string, []interface{}, error) {
+	var (
+		clauses []string
+		orderBy string
+		limit   string
+		err     error
+	)
+
+	clauses = []string{}
+
+	orderBy, args, err = sortsToSQLWithArgs(dialect, args, sorts, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if orderBy != "" {
+		clauses = append(clauses, fmt.Sprintf("order by %s", orderBy))
+	}
+
+	limit, args, err = dialect.LimitOffset(take, 0, orderBy != "", args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if limit != "" {
+		clauses = append(clauses, limit)
+	}
+
+	return strings.Join(clauses, " "), args, nil
+}
+
+func orderByLimitFilter(table string, filter *Filter, sorts []*Sort, take uint64) *Filter {
+	var selectQuery *SelectQuery = Select(newSystemField("ctid")).
+		From(NewTable(table)).
+		Where(filter).
+		OrderBy(sorts...).
+		Limit(take)
+
+	return NewFilter().SetCondition(newSystemField("ctid"), OperatorIn, NewSelectQueryFilterValue(selectQuery))
+}
@@ -0,0 +1,92 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_validateOrderByLimit(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Joins       []*Join
+		Sorts       []*Sort
+		Take        uint64
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Joins       []*Join
+		Sorts       []*Sort
+		Take        uint64
+		Expectation error
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s without order by and limit", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with order by and limit", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Sorts:       []*Sort{NewSort("field1", SortDirectionAscending)},
+			Take:        10,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with limit", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Take:        10,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with limit", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Take:        10,
+			Expectation: fmt.Errorf(errUnsupportedOrderByLimitForDialectf, DialectSQLite.Name()),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with order by", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Sorts:       []*Sort{NewSort("field1", SortDirectionAscending)},
+			Expectation: fmt.Errorf(errUnsupportedOrderByLimitForDialectf, DialectSQLServer.Name()),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with joins and limit", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Joins:       []*Join{NewJoin(JoinTypeInner, NewTable("table2"), NewFilter())},
+			Take:        10,
+			Expectation: ErrConflictJoinsAndOrderByOrLimit,
+		},
+		{
+			Name:        "custom dialect mariadb with limit",
+			Dialect:     mariaDBDialect{},
+			Take:        10,
+			Expectation: nil,
+		},
+		{
+			Name:        "dialect without order by and limit capability with limit",
+			Dialect:     wrappedDialect{DialectMySQL},
+			Take:        10,
+			Expectation: fmt.Errorf(errUnsupportedOrderByLimitForDialectf, DialectMySQL.Name()),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = validateOrderByLimit(testCases[i].Dialect, testCases[i].Joins, testCases[i].Sorts, testCases[i].Take)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
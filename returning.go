@@ -0,0 +1,45 @@
+package simple_query
+
+import (
+	"fmt"
+	"strings"
+)
+
+func validateReturning(dialect Dialect, fields []*Field) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if returningDialect, ok := dialect.(ReturningDialect); !ok || !returningDialect.SupportsReturning() {
+		return fmt.Errorf(errUnsupportedReturningForDialectf, dialect.Name())
+	}
+
+	for i := range fields {
+		if fields[i] == nil {
+			return ErrFieldIsNil
+		}
+	}
+
+	return nil
+}
+
+func returningToSQLWithArgs(dialect Dialect, args []interface{}, fields []*Field, options renderOptions) (string, []interface{}, error) {
+	var (
+		returnings []string
+		err        error
+	)
+
+	returnings = []string{}
+	for i := range fields {
+		var returning string
+
+		returning, args, err = fields[i].toSQLWithArgsWithAlias(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		returnings = append(returnings, returning)
+	}
+
+	return fmt.Sprintf("returning %s", strings.Join(returnings, ", ")), args, nil
+}
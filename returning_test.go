@@ -0,0 +1,182 @@
+package simple_query
+
+import (
+	"fmt"
+	"testing"
+)
+
+type mariaDBDialect struct {
+	mySQLDialect
+}
+
+func (mariaDBDialect) Name() string {
+	return "mariadb"
+}
+
+func (mariaDBDialect) SupportsReturning() bool {
+	return true
+}
+
+func Test_validateReturning(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Expectation error
+	}{
+		{
+			Name:        fmt.Sprintf("dialect %s without fields", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Fields:      nil,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with fields", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Fields:      []*Field{NewField("field1")},
+			Expectation: fmt.Errorf(errUnsupportedReturningForDialectf, DialectMySQL.Name()),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with fields", DialectSQLServer),
+			Dialect:     DialectSQLServer,
+			Fields:      []*Field{NewField("field1")},
+			Expectation: fmt.Errorf(errUnsupportedReturningForDialectf, DialectSQLServer.Name()),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with nil field", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Fields:      []*Field{nil},
+			Expectation: ErrFieldIsNil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with fields", DialectSQLite),
+			Dialect:     DialectSQLite,
+			Fields:      []*Field{NewField("field1")},
+			Expectation: nil,
+		},
+		{
+			Name:        "custom dialect with fields",
+			Dialect:     mariaDBDialect{},
+			Fields:      []*Field{NewField("field1")},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = validateReturning(testCases[i].Dialect, testCases[i].Fields)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func Test_returningToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Options     renderOptions
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Options     renderOptions
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "field is invalid",
+			Dialect: DialectPostgres,
+			Fields:  []*Field{{}},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with fields", DialectPostgres),
+			Dialect: DialectPostgres,
+			Fields: []*Field{
+				NewField("id"),
+				Coalesce(NewField("field1"), "value2").As("alias1"),
+			},
+			Options: renderOptions{quoteIdentifiers: true},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `returning "id", coalesce("field1", $2) as "alias1"`,
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = returningToSQLWithArgs(testCases[i].Dialect, []interface{}{"value1"}, testCases[i].Fields, testCases[i].Options)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Fatalf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package simple_query
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var identifierPartRegexp *regexp.Regexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type IdentifierError struct {
+	Identifier string
+	Err        error
+}
+
+func (e *IdentifierError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Identifier)
+}
+
+func (e *IdentifierError) Unwrap() error {
+	return e.Err
+}
+
+type Schema struct {
+	AllowedIdentifiers map[string]bool
+}
+
+func NewSchema(identifiers ...string) *Schema {
+	var schema *Schema = &Schema{
+		AllowedIdentifiers: map[string]bool{},
+	}
+
+	return schema.Allow(identifiers...)
+}
+
+func (s *Schema) Allow(identifiers ...string) *Schema {
+	if s.AllowedIdentifiers == nil {
+		s.AllowedIdentifiers = map[string]bool{}
+	}
+
+	for i := range identifiers {
+		s.AllowedIdentifiers[identifiers[i]] = true
+	}
+
+	return s
+}
+
+func (s *Schema) validateName(name string) error {
+	var parts []string = strings.Split(name, ".")
+
+	for i := range parts {
+		if parts[i] == "*" && i == len(parts)-1 {
+			continue
+		}
+
+		if !identifierPartRegexp.MatchString(parts[i]) {
+			return &IdentifierError{Identifier: name, Err: ErrIdentifierIsInvalid}
+		}
+	}
+
+	return nil
+}
+
+func (s *Schema) validateIdentifier(identifier string) error {
+	var (
+		parts []string
+		err   error
+	)
+
+	err = s.validateName(identifier)
+	if err != nil {
+		return err
+	}
+
+	if len(s.AllowedIdentifiers) == 0 || s.AllowedIdentifiers[identifier] {
+		return nil
+	}
+
+	parts = strings.Split(identifier, ".")
+	for i := range parts {
+		if parts[i] == "*" {
+			continue
+		}
+
+		if !s.AllowedIdentifiers[parts[i]] {
+			return &IdentifierError{Identifier: identifier, Err: ErrIdentifierIsNotAllowed}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package simple_query
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSchema_NewSchema(t *testing.T) {
+	var actual *Schema = NewSchema("table1", "field1")
+
+	if len(actual.AllowedIdentifiers) != 2 {
+		t.Errorf("expectation length of allowed identifiers is %d, got %d", 2, len(actual.AllowedIdentifiers))
+	}
+
+	if !actual.AllowedIdentifiers["table1"] || !actual.AllowedIdentifiers["field1"] {
+		t.Errorf("expectation allowed identifiers is %v, got %v", []string{"table1", "field1"}, actual.AllowedIdentifiers)
+	}
+}
+
+func TestSchema_Allow(t *testing.T) {
+	var actual *Schema = (&Schema{}).Allow("table1").Allow("field1", "field2")
+
+	if len(actual.AllowedIdentifiers) != 3 {
+		t.Errorf("expectation length of allowed identifiers is %d, got %d", 3, len(actual.AllowedIdentifiers))
+	}
+}
+
+func TestSchema_IdentifierError(t *testing.T) {
+	var err error = &IdentifierError{Identifier: "field1", Err: ErrIdentifierIsNotAllowed}
+
+	if err.Error() != "identifier is not allowed: field1" {
+		t.Errorf("expectation error is %s, got %s", "identifier is not allowed: field1", err.Error())
+	}
+
+	if !errors.Is(err, ErrIdentifierIsNotAllowed) {
+		t.Errorf("expectation error is %s, got %s", ErrIdentifierIsNotAllowed.Error(), err.Error())
+	}
+}
+
+func TestSchema_validateIdentifier(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Schema      *Schema
+		Identifier  string
+		Expectation error
+	} = []struct {
+		Name        string
+		Schema      *Schema
+		Identifier  string
+		Expectation error
+	}{
+		{
+			Name:        "identifier contains invalid character",
+			Schema:      NewSchema(),
+			Identifier:  "field1; drop table table1",
+			Expectation: &IdentifierError{Identifier: "field1; drop table table1", Err: ErrIdentifierIsInvalid},
+		},
+		{
+			Name:        "identifier starts with digit",
+			Schema:      NewSchema(),
+			Identifier:  "1field",
+			Expectation: &IdentifierError{Identifier: "1field", Err: ErrIdentifierIsInvalid},
+		},
+		{
+			Name:        "identifier part is empty",
+			Schema:      NewSchema(),
+			Identifier:  "table1.",
+			Expectation: &IdentifierError{Identifier: "table1.", Err: ErrIdentifierIsInvalid},
+		},
+		{
+			Name:        "identifier wildcard is not last part",
+			Schema:      NewSchema(),
+			Identifier:  "*.field1",
+			Expectation: &IdentifierError{Identifier: "*.field1", Err: ErrIdentifierIsInvalid},
+		},
+		{
+			Name:        "allowed identifiers is empty",
+			Schema:      NewSchema(),
+			Identifier:  "table1.field1",
+			Expectation: nil,
+		},
+		{
+			Name:        "identifier is not allowed",
+			Schema:      NewSchema("field1"),
+			Identifier:  "field2",
+			Expectation: &IdentifierError{Identifier: "field2", Err: ErrIdentifierIsNotAllowed},
+		},
+		{
+			Name:        "identifier part is not allowed",
+			Schema:      NewSchema("field1"),
+			Identifier:  "table1.field1",
+			Expectation: &IdentifierError{Identifier: "table1.field1", Err: ErrIdentifierIsNotAllowed},
+		},
+		{
+			Name:        "qualified identifier is allowed",
+			Schema:      NewSchema("table1.field1"),
+			Identifier:  "table1.field1",
+			Expectation: nil,
+		},
+		{
+			Name:        "identifier parts is allowed",
+			Schema:      NewSchema("table1", "field1"),
+			Identifier:  "table1.field1",
+			Expectation: nil,
+		},
+		{
+			Name:        "identifier with wildcard is allowed",
+			Schema:      NewSchema("table1"),
+			Identifier:  "table1.*",
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].Schema.validateIdentifier(testCases[i].Identifier)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
@@ -2,16 +2,26 @@ package simple_query
 
 import (
 	"fmt"
+	"math"
 	"strings"
 )
 
 type SelectQuery struct {
-	Fields []*Field
-	Table  *Table
-	Filter *Filter
-	Sorts  []*Sort
-	Take   uint64
-	Alias  string
+	CommonTableExpressions []*CommonTableExpression
+	Fields                 []*Field
+	Table                  *Table
+	Joins                  []*Join
+	Filter                 *Filter
+	Groups                 []*Field
+	HavingFilter           *Filter
+	Compounds              []*CompoundQuery
+	Sorts                  []*Sort
+	Take                   uint64
+	Skip                   uint64
+	Alias                  string
+
+	QuotedIdentifiers bool
+	Schema            *Schema
 }
 
 func Select(fields ...*Field) *SelectQuery {
@@ -20,16 +30,103 @@ func Select(fields ...*Field) *SelectQuery {
 	}
 }
 
+func (s *SelectQuery) With(name string, selectQuery *SelectQuery) *SelectQuery {
+	s.CommonTableExpressions = append(s.CommonTableExpressions, NewCommonTableExpression(name, selectQuery))
+	return s
+}
+
+func (s *SelectQuery) WithRecursive(name string, anchor, recursive *SelectQuery) *SelectQuery {
+	s.CommonTableExpressions = append(s.CommonTableExpressions, NewRecursiveCommonTableExpression(name, anchor, recursive))
+	return s
+}
+
 func (s *SelectQuery) From(table *Table) *SelectQuery {
 	s.Table = table
 	return s
 }
 
+func (s *SelectQuery) Join(table *Table, filter *Filter) *SelectQuery {
+	s.Joins = append(s.Joins, NewJoin(JoinTypeInner, table, filter))
+	return s
+}
+
+func (s *SelectQuery) LeftJoin(table *Table, filter *Filter) *SelectQuery {
+	s.Joins = append(s.Joins, NewJoin(JoinTypeLeft, table, filter))
+	return s
+}
+
+func (s *SelectQuery) RightJoin(table *Table, filter *Filter) *SelectQuery {
+	s.Joins = append(s.Joins, NewJoin(JoinTypeRight, table, filter))
+	return s
+}
+
+func (s *SelectQuery) FullJoin(table *Table, filter *Filter) *SelectQuery {
+	s.Joins = append(s.Joins, NewJoin(JoinTypeFull, table, filter))
+	return s
+}
+
+func (s *SelectQuery) CrossJoin(table *Table) *SelectQuery {
+	s.Joins = append(s.Joins, NewJoin(JoinTypeCross, table, nil))
+	return s
+}
+
 func (s *SelectQuery) Where(filter *Filter) *SelectQuery {
 	s.Filter = filter
 	return s
 }
 
+func (s *SelectQuery) GroupBy(fields ...*Field) *SelectQuery {
+	s.Groups = fields
+	return s
+}
+
+func (s *SelectQuery) Having(filter *Filter) *SelectQuery {
+	s.HavingFilter = filter
+	return s
+}
+
+func (s *SelectQuery) Union(selectQuery *SelectQuery) *SelectQuery {
+	return s.compound(SetOperatorUnion, selectQuery)
+}
+
+func (s *SelectQuery) UnionAll(selectQuery *SelectQuery) *SelectQuery {
+	return s.compound(SetOperatorUnionAll, selectQuery)
+}
+
+func (s *SelectQuery) Intersect(selectQuery *SelectQuery) *SelectQuery {
+	return s.compound(SetOperatorIntersect, selectQuery)
+}
+
+func (s *SelectQuery) Except(selectQuery *SelectQuery) *SelectQuery {
+	return s.compound(SetOperatorExcept, selectQuery)
+}
+
+func (s *SelectQuery) compound(operator SetOperator, selectQuery *SelectQuery) *SelectQuery {
+	if len(s.Compounds) == 0 {
+		var first SelectQuery = *s
+
+		first.CommonTableExpressions = nil
+		first.Sorts = nil
+		first.Take = 0
+		first.Skip = 0
+		first.Alias = ""
+
+		*s = SelectQuery{
+			CommonTableExpressions: s.CommonTableExpressions,
+			Compounds:              []*CompoundQuery{NewCompoundQuery("", &first)},
+			Sorts:                  s.Sorts,
+			Take:                   s.Take,
+			Skip:                   s.Skip,
+			Alias:                  s.Alias,
+			QuotedIdentifiers:      s.QuotedIdentifiers,
+			Schema:                 s.Schema,
+		}
+	}
+
+	s.Compounds = append(s.Compounds, NewCompoundQuery(operator, selectQuery))
+	return s
+}
+
 func (s *SelectQuery) OrderBy(sorts ...*Sort) *SelectQuery {
 	s.Sorts = sorts
 	return s
@@ -40,16 +137,65 @@ func (s *SelectQuery) Limit(take uint64) *SelectQuery {
 	return s
 }
 
+func (s *SelectQuery) Offset(skip uint64) *SelectQuery {
+	s.Skip = skip
+	return s
+}
+
+func (s *SelectQuery) Page(page, size uint64) *SelectQuery {
+	if page == 0 {
+		page = 1
+	}
+
+	s.Take = size
+
+	if size > 0 && page-1 > math.MaxInt64/size {
+		s.Skip = math.MaxUint64
+		return s
+	}
+
+	s.Skip = (page - 1) * size
+	return s
+}
+
 func (s *SelectQuery) As(alias string) *SelectQuery {
 	s.Alias = alias
 	return s
 }
 
+func (s *SelectQuery) QuoteIdentifiers() *SelectQuery {
+	s.QuotedIdentifiers = true
+	return s
+}
+
+func (s *SelectQuery) WithSchema(schema *Schema) *SelectQuery {
+	s.Schema = schema
+	return s
+}
+
+func (s *SelectQuery) hasAggregate() bool {
+	for i := range s.Fields {
+		if s.Fields[i].isAggregate() {
+			return true
+		}
+	}
+
+	return s.HavingFilter.hasAggregate()
+}
+
 func (s *SelectQuery) validate(dialect Dialect) error {
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
+	if s.Skip > math.MaxInt64 {
+		return ErrOffsetIsOverflow
+	}
+
+	if len(s.Compounds) > 0 {
+		return s.validateCompounds(dialect)
+	}
+
 	if len(s.Fields) == 0 {
 		return ErrFieldsIsRequired
 	}
@@ -64,30 +210,70 @@ func (s *SelectQuery) validate(dialect Dialect) error {
 		return ErrTableIsRequired
 	}
 
+	for i := range s.Joins {
+		if s.Joins[i] == nil {
+			return ErrJoinIsNil
+		}
+	}
+
+	for i := range s.Groups {
+		if s.Groups[i] == nil {
+			return ErrFieldIsNil
+		}
+	}
+
+	if s.HavingFilter != nil && len(s.Groups) == 0 && !s.hasAggregate() {
+		return ErrGroupByIsRequired
+	}
+
 	return nil
 }
 
-func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
-	var (
-		fields        []string
-		table         string
-		query         string
-		whereClause   string
-		orderBy       string
-		orderByClause []string
-		placeholder   string
-		err           error
-	)
+func (s *SelectQuery) resultFields() []*Field {
+	if len(s.Compounds) > 0 && s.Compounds[0] != nil && s.Compounds[0].SelectQuery != nil {
+		return s.Compounds[0].SelectQuery.resultFields()
+	}
 
-	err = s.validate(dialect)
-	if err != nil {
-		return "", nil, err
+	return s.Fields
+}
+
+func (s *SelectQuery) validateCompounds(dialect Dialect) error {
+	var err error
+
+	if len(s.Fields) > 0 || s.Table != nil || len(s.Joins) > 0 || s.Filter != nil || len(s.Groups) > 0 || s.HavingFilter != nil {
+		return ErrConflictCompoundsAndSelectClauses
+	}
+
+	for i := range s.Compounds {
+		if s.Compounds[i] == nil {
+			return ErrSelectQueryIsRequired
+		}
+
+		err = s.Compounds[i].validate(dialect, i == 0)
+		if err != nil {
+			return err
+		}
 	}
 
+	return nil
+}
+
+func (s *SelectQuery) selectToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		fields       []string
+		table        string
+		join         string
+		groups       []string
+		havingClause string
+		query        string
+		whereClause  string
+		err          error
+	)
+
 	for i := range s.Fields {
 		if s.Fields != nil {
 			var field string
-			field, args, err = s.Fields[i].ToSQLWithArgsWithAlias(dialect, args)
+			field, args, err = s.Fields[i].toSQLWithArgsWithAlias(dialect, args, options)
 			if err != nil {
 				return "", nil, err
 			}
@@ -97,7 +283,7 @@ func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 	}
 
 	if s.Table != nil {
-		table, args, err = s.Table.ToSQLWithArgsWithAlias(dialect, args)
+		table, args, err = s.Table.toSQLWithArgsWithAlias(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -105,8 +291,17 @@ func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 
 	query = fmt.Sprintf("select %s from %s", strings.Join(fields, ", "), table)
 
+	for i := range s.Joins {
+		join, args, err = s.Joins[i].toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s %s", query, join)
+	}
+
 	if s.Filter != nil {
-		whereClause, args, err = s.Filter.ToSQLWithArgs(dialect, args)
+		whereClause, args, err = s.Filter.toSQLWithArgsWithOptions(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -116,49 +311,207 @@ func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 		}
 	}
 
-	if len(s.Sorts) > 0 {
-		orderByClause = []string{}
-		for i := range s.Sorts {
-			if s.Sorts[i] == nil {
-				continue
-			}
-
-			orderBy, err = s.Sorts[i].ToSQL()
+	if len(s.Groups) > 0 {
+		groups = []string{}
+		for i := range s.Groups {
+			var group string
+			group, args, err = s.Groups[i].toSQLWithArgs(dialect, args, options)
 			if err != nil {
 				return "", nil, err
 			}
 
-			orderByClause = append(orderByClause, orderBy)
+			groups = append(groups, group)
+		}
+
+		query = fmt.Sprintf("%s group by %s", query, strings.Join(groups, ", "))
+	}
+
+	if s.HavingFilter != nil {
+		havingClause, args, err = s.HavingFilter.toSQLWithArgsWithOptions(dialect, args, options)
+		if err != nil {
+			return "", nil, err
 		}
 
-		if len(orderByClause) > 0 {
-			query = fmt.Sprintf("%s order by %s", query, strings.Join(orderByClause, ", "))
+		if havingClause != "" {
+			query = fmt.Sprintf("%s having %s", query, havingClause)
 		}
 	}
 
-	if s.Take > 0 {
-		args = append(args, s.Take)
-		placeholder = getPlaceholder(dialect, len(args), len(args))
-		query = fmt.Sprintf("%s limit %s", query, placeholder)
+	return query, args, nil
+}
+
+func (s *SelectQuery) compoundsToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		queries []string
+		err     error
+	)
+
+	queries = []string{}
+	for i := range s.Compounds {
+		var query string
+
+		query, args, err = s.Compounds[i].toSQLWithArgs(dialect, args, i == 0, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	return strings.Join(queries, " "), args, nil
+}
+
+func (s *SelectQuery) commonTableExpressions() []*CommonTableExpression {
+	var commonTableExpressions []*CommonTableExpression = []*CommonTableExpression{}
+
+	commonTableExpressions = append(commonTableExpressions, s.CommonTableExpressions...)
+	for i := range s.Compounds {
+		if s.Compounds[i] == nil || s.Compounds[i].SelectQuery == nil {
+			continue
+		}
+
+		commonTableExpressions = append(commonTableExpressions, s.Compounds[i].SelectQuery.commonTableExpressions()...)
+	}
+
+	return commonTableExpressions
+}
+
+func (s *SelectQuery) queryToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		query      string
+		orderBy    string
+		pagination string
+		err        error
+	)
+
+	if len(s.Compounds) > 0 {
+		query, args, err = s.compoundsToSQLWithArgs(dialect, args, options)
+	} else {
+		query, args, err = s.selectToSQLWithArgs(dialect, args, options)
+	}
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	orderBy, args, err = sortsToSQLWithArgs(dialect, args, s.Sorts, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if orderBy != "" {
+		query = fmt.Sprintf("%s order by %s", query, orderBy)
+	}
+
+	pagination, args, err = dialect.LimitOffset(s.Take, s.Skip, orderBy != "", args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if pagination != "" {
+		query = fmt.Sprintf("%s %s", query, pagination)
 	}
 
 	return query, args, nil
 }
 
-func (s *SelectQuery) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (s *SelectQuery) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		withClause string
+		query      string
+		err        error
+	)
+
+	err = s.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	options = options.merge(s.QuotedIdentifiers, s.Schema)
+
+	withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, s.commonTableExpressions(), options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query, args, err = s.queryToSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if withClause != "" {
+		query = fmt.Sprintf("%s %s", withClause, query)
+	}
+
+	return query, args, nil
+}
+
+func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return s.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func (s *SelectQuery) ToCountSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	var (
+		countQuery SelectQuery
+		withClause string
+		query      string
+		err        error
+	)
+
+	err = s.validate(dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, s.commonTableExpressions(), renderOptions{quoteIdentifiers: s.QuotedIdentifiers, schema: s.Schema})
+	if err != nil {
+		return "", nil, err
+	}
+
+	countQuery = *s
+	countQuery.Sorts = nil
+	countQuery.Take = 0
+	countQuery.Skip = 0
+	countQuery.Alias = ""
+
+	query, args, err = countQuery.queryToSQLWithArgs(dialect, args, renderOptions{quoteIdentifiers: s.QuotedIdentifiers, schema: s.Schema})
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("select count(*) from (%s) as %s", query, renderOptions{quoteIdentifiers: s.QuotedIdentifiers}.quote(dialect, "count_query"))
+	if withClause != "" {
+		query = fmt.Sprintf("%s %s", withClause, query)
+	}
+
+	return query, args, nil
+}
+
+func (s *SelectQuery) toSQLWithArgsWithAlias(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		query string
 		err   error
 	)
 
-	query, args, err = s.ToSQLWithArgs(dialect, args)
+	query, args, err = s.toSQLWithArgs(dialect, args, options)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if s.Alias != "" {
-		query = fmt.Sprintf("(%s) as %s", query, s.Alias)
+		var alias string
+
+		alias, err = options.merge(s.QuotedIdentifiers, s.Schema).alias(dialect, s.Alias)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("(%s) as %s", query, alias)
 	}
 
 	return query, args, nil
 }
+
+func (s *SelectQuery) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return s.toSQLWithArgsWithAlias(dialect, args, renderOptions{})
+}
@@ -2,6 +2,7 @@ package simple_query
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
@@ -38,6 +39,16 @@ func testSelectQuery_SelectQueryEquality(t *testing.T, expectation, actual *Sele
 		t.Errorf("expectation table is %+v, got %+v", expectation.Table, actual.Table)
 	}
 
+	if len(expectation.Joins) != len(actual.Joins) {
+		t.Errorf("expectation length of joins is %d, got %d", len(expectation.Joins), len(actual.Joins))
+	} else {
+		for i := range expectation.Joins {
+			if !deepEqual(expectation.Joins[i], actual.Joins[i]) {
+				t.Errorf("expectation element of joins is %+v, got %+v", expectation.Joins[i], actual.Joins[i])
+			}
+		}
+	}
+
 	if expectation.Filter != nil && actual.Filter == nil {
 		t.Errorf("expectation filter is %+v, got nil", expectation.Filter)
 	}
@@ -48,6 +59,20 @@ func testSelectQuery_SelectQueryEquality(t *testing.T, expectation, actual *Sele
 		t.Errorf("expectation table is %+v, got %+v", expectation.Filter, actual.Filter)
 	}
 
+	if len(expectation.Groups) != len(actual.Groups) {
+		t.Errorf("expectation length of groups is %d, got %d", len(expectation.Groups), len(actual.Groups))
+	} else {
+		for i := range expectation.Groups {
+			if !deepEqual(expectation.Groups[i], actual.Groups[i]) {
+				t.Errorf("expectation element of groups is %+v, got %+v", expectation.Groups[i], actual.Groups[i])
+			}
+		}
+	}
+
+	if !deepEqual(expectation.HavingFilter, actual.HavingFilter) {
+		t.Errorf("expectation having filter is %+v, got %+v", expectation.HavingFilter, actual.HavingFilter)
+	}
+
 	if len(expectation.Sorts) != len(actual.Sorts) {
 		t.Errorf("expectation length of sorts is %d, got %d", len(expectation.Sorts), len(actual.Sorts))
 	} else {
@@ -62,9 +87,17 @@ func testSelectQuery_SelectQueryEquality(t *testing.T, expectation, actual *Sele
 		t.Errorf("expectation take is %d, got %d", expectation.Take, actual.Take)
 	}
 
+	if expectation.Skip != actual.Skip {
+		t.Errorf("expectation skip is %d, got %d", expectation.Skip, actual.Skip)
+	}
+
 	if expectation.Alias != actual.Alias {
 		t.Errorf("expectation alias is %s, got %s", expectation.Alias, actual.Alias)
 	}
+
+	if expectation.QuotedIdentifiers != actual.QuotedIdentifiers {
+		t.Errorf("expectation quoted identifiers is %t, got %t", expectation.QuotedIdentifiers, actual.QuotedIdentifiers)
+	}
 }
 
 func TestSelectQuery_Select(t *testing.T) {
@@ -121,6 +154,64 @@ func TestSelectQuery_From(t *testing.T) {
 	testSelectQuery_SelectQueryEquality(t, expectation, actual)
 }
 
+func TestSelectQuery_Join(t *testing.T) {
+	var (
+		joinFilter  *Filter
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	joinFilter = NewFilter().
+		SetCondition(NewField("field1").FromTable("table2"), OperatorEqual, NewFilterValue("value1"))
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		Joins: []*Join{
+			{
+				Type:   JoinTypeInner,
+				Table:  &Table{Name: "table2"},
+				Filter: joinFilter,
+			},
+			{
+				Type:   JoinTypeLeft,
+				Table:  &Table{Name: "table3"},
+				Filter: joinFilter,
+			},
+			{
+				Type:   JoinTypeRight,
+				Table:  &Table{Name: "table4"},
+				Filter: joinFilter,
+			},
+			{
+				Type:   JoinTypeFull,
+				Table:  &Table{Name: "table5"},
+				Filter: joinFilter,
+			},
+			{
+				Type:  JoinTypeCross,
+				Table: &Table{Name: "table6"},
+			},
+		},
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		Join(NewTable("table2"), joinFilter).
+		LeftJoin(NewTable("table3"), joinFilter).
+		RightJoin(NewTable("table4"), joinFilter).
+		FullJoin(NewTable("table5"), joinFilter).
+		CrossJoin(NewTable("table6"))
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
 func TestSelectQuery_Where(t *testing.T) {
 	var (
 		expectation *SelectQuery
@@ -169,6 +260,83 @@ func TestSelectQuery_Where(t *testing.T) {
 	testSelectQuery_SelectQueryEquality(t, expectation, actual)
 }
 
+func TestSelectQuery_GroupBy(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+			{
+				Column: "field2",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		Groups: []*Field{
+			{
+				Column: "field1",
+			},
+			{
+				Column: "field2",
+			},
+		},
+	}
+
+	actual = Select(NewField("field1"), NewField("field2")).
+		From(NewTable("table1")).
+		GroupBy(NewField("field1"), NewField("field2"))
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_Having(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		Groups: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		HavingFilter: &Filter{
+			Field: &Field{
+				Column: "field1",
+			},
+			Operator: OperatorNotEqual,
+			Value: &FilterValue{
+				Value: "value1",
+			},
+		},
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		GroupBy(NewField("field1")).
+		Having(
+			NewFilter().
+				SetCondition(NewField("field1"), OperatorNotEqual, NewFilterValue("value1")),
+		)
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
 func TestSelectQuery_OrderBy(t *testing.T) {
 	var (
 		expectation *SelectQuery
@@ -243,6 +411,126 @@ func TestSelectQuery_Limit(t *testing.T) {
 	testSelectQuery_SelectQueryEquality(t, expectation, actual)
 }
 
+func TestSelectQuery_Offset(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		Take: 10,
+		Skip: 20,
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		Limit(10).
+		Offset(20)
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_Page(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Page        uint64
+		Size        uint64
+		Expectation *SelectQuery
+	} = []struct {
+		Name        string
+		Page        uint64
+		Size        uint64
+		Expectation *SelectQuery
+	}{
+		{
+			Name: "page is zero",
+			Page: 0,
+			Size: 10,
+			Expectation: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Take: 10,
+				Skip: 0,
+			},
+		},
+		{
+			Name: "page is first page",
+			Page: 1,
+			Size: 10,
+			Expectation: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Take: 10,
+				Skip: 0,
+			},
+		},
+		{
+			Name: "page is third page",
+			Page: 3,
+			Size: 10,
+			Expectation: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Take: 10,
+				Skip: 20,
+			},
+		},
+		{
+			Name: "page offset is overflow",
+			Page: math.MaxUint64,
+			Size: 10,
+			Expectation: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Take: 10,
+				Skip: math.MaxUint64,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *SelectQuery = Select(NewField("field1")).
+				From(NewTable("table1")).
+				Page(testCases[i].Page, testCases[i].Size)
+
+			testSelectQuery_SelectQueryEquality(t, testCases[i].Expectation, actual)
+		})
+	}
+}
+
 func TestSelectQuery_As(t *testing.T) {
 	var (
 		expectation *SelectQuery
@@ -274,6 +562,41 @@ func TestSelectQuery_As(t *testing.T) {
 	testSelectQuery_SelectQueryEquality(t, expectation, actual)
 }
 
+func TestSelectQuery_QuoteIdentifiers(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		QuotedIdentifiers: true,
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		QuoteIdentifiers()
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_WithSchema(t *testing.T) {
+	var actual *SelectQuery = Select(NewField("field1")).
+		From(NewTable("table1")).
+		WithSchema(NewSchema("table1", "field1"))
+
+	if actual.Schema == nil {
+		t.Error("expectation schema is not nil, got nil")
+	}
+}
+
 func TestSelectQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -288,10 +611,35 @@ func TestSelectQuery_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			SelectQuery: &SelectQuery{},
 			Expectation: ErrDialectIsRequired,
 		},
+		{
+			Name:    "page offset is overflow",
+			Dialect: DialectPostgres,
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Page(math.MaxUint64/2, 4),
+			Expectation: ErrOffsetIsOverflow,
+		},
+		{
+			Name:    "offset is overflow",
+			Dialect: DialectPostgres,
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Offset(math.MaxUint64),
+			Expectation: ErrOffsetIsOverflow,
+		},
+		{
+			Name:    "page after page offset is overflow",
+			Dialect: DialectPostgres,
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Page(math.MaxUint64/2, 4).
+				Page(1, 10),
+			Expectation: nil,
+		},
 		{
 			Name:        "fields is empty",
 			Dialect:     DialectPostgres,
@@ -319,7 +667,7 @@ func TestSelectQuery_validate(t *testing.T) {
 			Expectation: ErrTableIsRequired,
 		},
 		{
-			Name:    "select query is valid",
+			Name:    "joins element is nil",
 			Dialect: DialectPostgres,
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
@@ -330,32 +678,121 @@ func TestSelectQuery_validate(t *testing.T) {
 				Table: &Table{
 					Name: "table1",
 				},
+				Joins: []*Join{nil},
 			},
-			Expectation: nil,
+			Expectation: ErrJoinIsNil,
 		},
-	}
-
-	for i := range testCases {
-		t.Run(testCases[i].Name, func(t *testing.T) {
-			var actual error = testCases[i].SelectQuery.validate(testCases[i].Dialect)
-
-			if testCases[i].Expectation != nil && actual == nil {
-				t.Error("expectation error is not nil, got nil")
-			}
-
-			if testCases[i].Expectation == nil && actual != nil {
-				t.Error("expectation error is nil, got not nil")
-			}
-
-			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
-				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
-			}
-		})
-	}
-}
-
-func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
-	var testCases []struct {
+		{
+			Name:    "groups element is nil",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Groups: []*Field{nil},
+			},
+			Expectation: ErrFieldIsNil,
+		},
+		{
+			Name:    "having filter is not nil and groups is empty",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				HavingFilter: &Filter{},
+			},
+			Expectation: ErrGroupByIsRequired,
+		},
+		{
+			Name:    "having filter is not nil and groups is empty and fields contain aggregate",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Function: "count",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				HavingFilter: &Filter{},
+			},
+			Expectation: nil,
+		},
+		{
+			Name:    "having filter is not nil and groups is empty and having filter contains aggregate",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				HavingFilter: &Filter{
+					Logic: LogicAnd,
+					Filters: []*Filter{
+						{
+							Field: &Field{
+								Function: "sum",
+							},
+						},
+					},
+				},
+			},
+			Expectation: nil,
+		},
+		{
+			Name:    "select query is valid",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+			},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].SelectQuery.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
 		Name        string
 		SelectQuery *SelectQuery
 		Dialect     Dialect
@@ -452,7 +889,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with invalid filter", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with invalid join", DialectMySQL),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
@@ -462,12 +899,16 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Table: &Table{
 					Name: "table1",
 				},
-				Filter: &Filter{
-					Logic:   LogicAnd,
-					Filters: []*Filter{},
+				Joins: []*Join{
+					{
+						Type: JoinTypeFull,
+						Table: &Table{
+							Name: "table2",
+						},
+					},
 				},
 			},
-			Dialect: DialectPostgres,
+			Dialect: DialectMySQL,
 			Expectation: struct {
 				Query string
 				Args  []interface{}
@@ -475,60 +916,160 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFiltersIsRequired,
+				Err:   fmt.Errorf(errUnsupportedJoinTypeForDialectf, JoinTypeFull, DialectMySQL),
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with filter", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with joins and filter", DialectMySQL),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
+						Table:  "t1",
 						Column: "field1",
 					},
+					{
+						Table:  "t2",
+						Column: "field2",
+					},
 				},
 				Table: &Table{
-					Name: "table1",
+					Name:  "table1",
+					Alias: "t1",
 				},
-				Filter: &Filter{
-					Logic: LogicAnd,
-					Filters: []*Filter{
-						{
+				Joins: []*Join{
+					{
+						Type: JoinTypeInner,
+						Table: &Table{
+							Name:  "table2",
+							Alias: "t2",
+						},
+						Filter: &Filter{
 							Field: &Field{
-								Column: "field1",
+								Table:  "t2",
+								Column: "field3",
 							},
 							Operator: OperatorEqual,
 							Value: &FilterValue{
-								Value: "value1",
+								Value: "value3",
 							},
 						},
 					},
+					{
+						Type: JoinTypeCross,
+						Table: &Table{
+							Name:  "table3",
+							Alias: "t3",
+						},
+					},
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Table:  "t1",
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
 				},
+				Take: 10,
 			},
-			Dialect: DialectPostgres,
+			Dialect: DialectMySQL,
 			Expectation: struct {
 				Query string
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "select field1 from table1 where field1 = $1",
-				Args:  []interface{}{"value1"},
+				Query: "select t1.field1, t2.field2 from table1 as t1 inner join table2 as t2 on t2.field3 = ? cross join table3 as t3 where t1.field1 = ? limit ?",
+				Args:  []interface{}{"value3", "value1", 10},
 				Err:   nil,
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with element sorts is nil", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with joins and filter", DialectPostgres),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
+						Table:  "t1",
 						Column: "field1",
 					},
+					{
+						Table:  "t2",
+						Column: "field2",
+					},
 				},
 				Table: &Table{
-					Name: "table1",
+					Name:  "table1",
+					Alias: "t1",
 				},
-				Sorts: []*Sort{
-					nil,
+				Joins: []*Join{
+					{
+						Type: JoinTypeLeft,
+						Table: &Table{
+							SelectQuery: &SelectQuery{
+								Fields: []*Field{
+									{
+										Column: "field2",
+									},
+									{
+										Column: "field3",
+									},
+								},
+								Table: &Table{
+									Name: "table2",
+								},
+								Filter: &Filter{
+									Field: &Field{
+										Column: "field4",
+									},
+									Operator: OperatorEqual,
+									Value: &FilterValue{
+										Value: "value4",
+									},
+								},
+							},
+							Alias: "t2",
+						},
+						Filter: &Filter{
+							Field: &Field{
+								Table:  "t2",
+								Column: "field3",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value3",
+							},
+						},
+					},
+					{
+						Type: JoinTypeFull,
+						Table: &Table{
+							Name:  "table3",
+							Alias: "t3",
+						},
+						Filter: &Filter{
+							Field: &Field{
+								Table:  "t3",
+								Column: "field5",
+							},
+							Operator: OperatorIn,
+							Value: &FilterValue{
+								Value: []int{1, 2},
+							},
+						},
+					},
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Table:  "t1",
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
 				},
+				Take: 10,
 			},
 			Dialect: DialectPostgres,
 			Expectation: struct {
@@ -536,13 +1077,13 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "select field1 from table1",
-				Args:  []interface{}{},
+				Query: "select t1.field1, t2.field2 from table1 as t1 left join (select field2, field3 from table2 where field4 = $1) as t2 on t2.field3 = $2 full join table3 as t3 on t3.field5 in ($3, $4) where t1.field1 = $5 limit $6",
+				Args:  []interface{}{"value4", "value3", 1, 2, "value1", 10},
 				Err:   nil,
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with invalid sort", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with invalid filter", DialectPostgres),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
@@ -552,13 +1093,10 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Table: &Table{
 					Name: "table1",
 				},
-				Sorts: []*Sort{
-					{
-						Field:     "",
-						Direction: SortDirectionDescending,
-					},
+				Filter: &Filter{
+					Logic:   LogicAnd,
+					Filters: []*Filter{},
 				},
-				Take: 100,
 			},
 			Dialect: DialectPostgres,
 			Expectation: struct {
@@ -568,11 +1106,11 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFieldIsRequired,
+				Err:   ErrFiltersIsRequired,
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with sort", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with filter", DialectPostgres),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
@@ -582,10 +1120,18 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Table: &Table{
 					Name: "table1",
 				},
-				Sorts: []*Sort{
-					{
-						Field:     "field1",
-						Direction: SortDirectionDescending,
+				Filter: &Filter{
+					Logic: LogicAnd,
+					Filters: []*Filter{
+						{
+							Field: &Field{
+								Column: "field1",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value1",
+							},
+						},
 					},
 				},
 			},
@@ -595,13 +1141,13 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "select field1 from table1 order by field1 desc",
-				Args:  []interface{}{},
+				Query: "select field1 from table1 where field1 = $1",
+				Args:  []interface{}{"value1"},
 				Err:   nil,
 			},
 		},
 		{
-			Name: fmt.Sprintf("dialect %s with take", DialectPostgres),
+			Name: fmt.Sprintf("dialect %s with invalid group by", DialectPostgres),
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{
 					{
@@ -611,7 +1157,9 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Table: &Table{
 					Name: "table1",
 				},
-				Take: 10,
+				Groups: []*Field{
+					{},
+				},
 			},
 			Dialect: DialectPostgres,
 			Expectation: struct {
@@ -619,49 +1167,1404 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Args  []interface{}
 				Err   error
 			}{
-				Query: "select field1 from table1 limit $1",
-				Args:  []interface{}{10},
-				Err:   nil,
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
 			},
 		},
-	}
-
-	for i := range testCases {
-		t.Run(testCases[i].Name, func(t *testing.T) {
-			var (
-				actualQuery string
-				actualArgs  []interface{}
-				actualErr   error
-			)
-
-			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
-
-			if testCases[i].Expectation.Err != nil && actualErr == nil {
-				t.Error("expectation error is not nil, got nil")
-			}
-
-			if testCases[i].Expectation.Err == nil && actualErr != nil {
-				t.Error("expectation error is nil, got not nil")
-			}
-
-			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
-				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
-			}
-
-			if testCases[i].Expectation.Query != actualQuery {
-				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
-			}
-
-			if len(testCases[i].Expectation.Args) != len(actualArgs) {
-				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
-			}
-
-			for j := range testCases[i].Expectation.Args {
-				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
-					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
-				}
-			}
-		})
+		{
+			Name: fmt.Sprintf("dialect %s with invalid having", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				HavingFilter: &Filter{},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with group by and having", DialectMySQL),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+					{
+						Column: "field2",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field3",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value3",
+					},
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+					{
+						Column: "field2",
+					},
+				},
+				HavingFilter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorNotEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Take: 10,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1, field2 from table1 where field3 = ? group by field1, field2 having field1 != ? limit ?",
+				Args:  []interface{}{"value3", "value1", 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with group by and having", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field3",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value3",
+					},
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				HavingFilter: &Filter{
+					Logic: LogicOr,
+					Filters: []*Filter{
+						{
+							Field: &Field{
+								Column: "field1",
+							},
+							Operator: OperatorIn,
+							Value: &FilterValue{
+								Value: []string{"value1", "value2"},
+							},
+						},
+						{
+							Field: &Field{
+								Column: "field1",
+							},
+							Operator: OperatorIsNull,
+						},
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "field1",
+						Direction: SortDirectionAscending,
+					},
+				},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field3 = $1 group by field1 having field1 in ($2, $3) or field1 is null order by field1 asc",
+				Args:  []interface{}{"value3", "value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with aggregate fields and expression sort", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+					{
+						Function: "count",
+						Alias:    "total",
+					},
+					{
+						Function: "sum",
+						FunctionArgs: []interface{}{
+							&Field{
+								Function: "coalesce",
+								FunctionArgs: []interface{}{
+									&Field{
+										Column: "field2",
+									},
+									0,
+								},
+							},
+						},
+						Alias: "amount",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				HavingFilter: &Filter{
+					Field: &Field{
+						Function: "sum",
+						FunctionArgs: []interface{}{
+							&Field{
+								Column: "field2",
+							},
+						},
+					},
+					Operator: OperatorGreaterThan,
+					Value: &FilterValue{
+						Value: 100,
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Expression: &Field{
+							Function: "count",
+						},
+						Direction: SortDirectionDescending,
+					},
+				},
+				Take: 10,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1, count(*) as total, sum(coalesce(field2, $1)) as amount from table1 group by field1 having sum(field2) > $2 order by count(*) desc limit $3",
+				Args:  []interface{}{0, 100, 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with element sorts is nil", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Sorts: []*Sort{
+					nil,
+				},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with invalid sort", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "",
+						Direction: SortDirectionDescending,
+					},
+				},
+				Take: 100,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with sort", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "field1",
+						Direction: SortDirectionDescending,
+					},
+				},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 order by field1 desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with take", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Take: 10,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit $1",
+				Args:  []interface{}{10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with skip", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Skip: 20,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 offset $1",
+				Args:  []interface{}{20},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with take and skip", DialectMySQL),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Take: 10,
+				Skip: 20,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field1 = ? limit ? offset ?",
+				Args:  []interface{}{"value1", 10, 20},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with take and skip", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Take: 10,
+				Skip: 20,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field1 = $1 limit $2 offset $3",
+				Args:  []interface{}{"value1", 10, 20},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with quoted identifiers", DialectMySQL),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Table:  "t1",
+						Column: "order",
+						Alias:  "order_alias",
+					},
+					{
+						Function: "count",
+						FunctionArgs: []interface{}{
+							&Field{
+								Column: "user",
+							},
+						},
+					},
+				},
+				Table: &Table{
+					Name:  "table1",
+					Alias: "t1",
+				},
+				Joins: []*Join{
+					{
+						Type: JoinTypeInner,
+						Table: &Table{
+							SelectQuery: &SelectQuery{
+								Fields: []*Field{
+									{
+										Column: "group",
+									},
+								},
+								Table: &Table{
+									Name: "table2",
+								},
+							},
+							Alias: "t2",
+						},
+						Filter: &Filter{
+							Field: &Field{
+								Table:  "t2",
+								Column: "group",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value1",
+							},
+						},
+					},
+				},
+				Groups: []*Field{
+					{
+						Table:  "t1",
+						Column: "order",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "t1.order",
+						Direction: SortDirectionDescending,
+					},
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select `t1`.`order` as `order_alias`, count(`user`) from `table1` as `t1` inner join (select `group` from `table2`) as `t2` on `t2`.`group` = ? group by `t1`.`order` order by `t1`.`order` desc",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with quoted identifiers", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "UserName",
+					},
+				},
+				Table: &Table{
+					Name: "user",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "Id",
+					},
+					Operator: OperatorIn,
+					Value: &FilterValue{
+						SelectQuery: &SelectQuery{
+							Fields: []*Field{
+								{
+									Column: "UserId",
+								},
+							},
+							Table: &Table{
+								Name: "order",
+							},
+						},
+					},
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `select "UserName" from "user" where "Id" in (select "UserId" from "order")`,
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and allowed identifiers", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+						Alias:  "alias1",
+					},
+					{
+						Function: "count",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field2",
+					},
+					Operator: OperatorIn,
+					Value: &FilterValue{
+						SelectQuery: &SelectQuery{
+							Fields: []*Field{
+								{
+									Column: "field2",
+								},
+							},
+							Table: &Table{
+								Name: "table2",
+							},
+						},
+					},
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field: "field1",
+					},
+				},
+				Schema: NewSchema("table1", "table2", "field1", "field2"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 as alias1, count(*) from table1 where field2 in (select field2 from table2) group by field1 order by field1 asc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and sort identifier is not allowed", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Sorts: []*Sort{
+					{
+						Field: "field1; drop table table1",
+					},
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field1; drop table table1", Err: ErrIdentifierIsInvalid},
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and nested filter identifier is not allowed", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field2",
+					},
+					Operator: OperatorIn,
+					Value: &FilterValue{
+						SelectQuery: &SelectQuery{
+							Fields: []*Field{
+								{
+									Column: "password",
+								},
+							},
+							Table: &Table{
+								Name: "table2",
+							},
+						},
+					},
+				},
+				Schema: NewSchema("table1", "table2", "field1", "field2"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "password", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and invalid alias", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+						Alias:  "alias 1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Schema: NewSchema(),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "alias 1", Err: ErrIdentifierIsInvalid},
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and invalid function", DialectPostgres),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Function: "pg_sleep(10); select",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Schema: NewSchema(),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "pg_sleep(10); select", Err: ErrIdentifierIsInvalid},
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with joins, filter, take and skip", DialectSQLite),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Table:  "t1",
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name:  "table1",
+					Alias: "t1",
+				},
+				Joins: []*Join{
+					{
+						Type: JoinTypeFull,
+						Table: &Table{
+							Name:  "table2",
+							Alias: "t2",
+						},
+						Filter: &Filter{
+							Field: &Field{
+								Table:  "t2",
+								Column: "field2",
+							},
+							Operator: OperatorEqual,
+							Value: &FilterValue{
+								Value: "value2",
+							},
+						},
+					},
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Table:  "t1",
+						Column: "field1",
+					},
+					Operator: OperatorLike,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Take: 10,
+				Skip: 20,
+			},
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select t1.field1 from table1 as t1 full join table2 as t2 on t2.field2 = ? where lower(t1.field1) like lower('%' || ? || '%') limit ? offset ?",
+				Args:  []interface{}{"value2", "value1", 10, 20},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with skip and without take", DialectMySQL),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Skip: 10,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLimitIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with skip and without take", DialectSQLite),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Skip: 10,
+			},
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLimitIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with take", DialectSQLServer),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+					{
+						Function: "coalesce",
+						FunctionArgs: []interface{}{
+							&Field{
+								Column: "field2",
+							},
+							"value2",
+						},
+						Alias: "alias2",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "field1",
+						Direction: SortDirectionDescending,
+					},
+				},
+				Take:              10,
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select [field1], coalesce([field2], @p1) as [alias2] from [table1] where [field1] = @p2 order by [field1] desc offset @p3 rows fetch next @p4 rows only",
+				Args:  []interface{}{"value2", "value1", 0, 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with take, skip and sort", DialectSQLServer),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorLike,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "field1",
+						Direction: SortDirectionAscending,
+					},
+				},
+				Take: 10,
+				Skip: 20,
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field1 like concat('%', @p1, '%') order by field1 asc offset @p2 rows fetch next @p3 rows only",
+				Args:  []interface{}{"value1", 20, 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with skip and without sort", DialectSQLServer),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Skip: 20,
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 order by (select null) offset @p1 rows",
+				Args:  []interface{}{20},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with recursive common table expression", DialectPostgres),
+			SelectQuery: Select(NewField("id")).
+				WithRecursive("tree", Select(NewField("id"), NewField("parent_id")).From(NewTable("categories")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))), Select(NewField("c.id"), NewField("c.parent_id")).From(NewTable("categories").As("c")).CrossJoin(NewTable("tree").As("t")).Where(NewFilter().SetCondition(NewField("c.depth"), OperatorLessThan, NewFilterValue(5)))).
+				From(NewTable("tree")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorGreaterThan, NewFilterValue(2))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with recursive tree as (select id, parent_id from categories where id = $1 union all select c.id, c.parent_id from categories as c cross join tree as t where c.depth < $2) select id from tree where id > $3",
+				Args:  []interface{}{1, 5, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with recursive common table expression", DialectSQLServer),
+			SelectQuery: Select(NewField("id")).
+				WithRecursive("tree", Select(NewField("id"), NewField("parent_id")).From(NewTable("categories")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))), Select(NewField("c.id"), NewField("c.parent_id")).From(NewTable("categories").As("c")).CrossJoin(NewTable("tree").As("t")).Where(NewFilter().SetCondition(NewField("c.depth"), OperatorLessThan, NewFilterValue(5)))).
+				From(NewTable("tree")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorGreaterThan, NewFilterValue(2))),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with tree as (select id, parent_id from categories where id = @p1 union all select c.id, c.parent_id from categories as c cross join tree as t where c.depth < @p2) select id from tree where id > @p3",
+				Args:  []interface{}{1, 5, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with common table expressions", DialectMySQL),
+			SelectQuery: Select(NewField("cte1.field1"), NewField("cte2.field2")).
+				With("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field3"), OperatorEqual, NewFilterValue("value3")))).
+				With("cte2", Select(NewField("field2")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field4"), OperatorEqual, NewFilterValue("value4")))).
+				From(NewTable("cte1")).
+				CrossJoin(NewTable("cte2")).
+				Where(NewFilter().SetCondition(NewField("cte1.field1"), OperatorEqual, NewFilterValue("value1"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table1 where field3 = ?), cte2 as (select field2 from table2 where field4 = ?) select cte1.field1, cte2.field2 from cte1 cross join cte2 where cte1.field1 = ?",
+				Args:  []interface{}{"value3", "value4", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "common table expression is nil",
+			SelectQuery: &SelectQuery{
+				CommonTableExpressions: []*CommonTableExpression{nil},
+				Fields:                 []*Field{NewField("field1")},
+				Table:                  NewTable("table1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrCommonTableExpressionIsNil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union, sorts, take and skip", DialectPostgres),
+			SelectQuery: Union(
+				Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value1"))),
+				Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			).
+				OrderBy(NewSort("field1", SortDirectionAscending)).
+				Limit(10).
+				Offset(20),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field2 = $1 union select field1 from table2 where field2 = $2 order by field1 asc limit $3 offset $4",
+				Args:  []interface{}{"value1", "value2", uint64(10), uint64(20)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with chained union all and except", DialectMySQL),
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				UnionAll(Select(NewField("field1")).From(NewTable("table2"))).
+				Except(Select(NewField("field1")).From(NewTable("table3"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 union all select field1 from table2 except select field1 from table3",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with intersect and take", DialectSQLServer),
+			SelectQuery: Intersect(
+				Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value1"))),
+				Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			).
+				Limit(10),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field2 = @p1 intersect select field1 from table2 where field2 = @p2 order by (select null) offset @p3 rows fetch next @p4 rows only",
+				Args:  []interface{}{"value1", "value2", uint64(0), uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union as derived table", DialectPostgres),
+			SelectQuery: Select(NewField("u.field1")).
+				From(NewSelectQueryTable(Union(Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value1"))), Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))))).As("u")).
+				Where(NewFilter().SetCondition(NewField("u.field1"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.field1 from (select field1 from table1 where field2 = $1 union select field1 from table2 where field2 = $2) as u where u.field1 = $3",
+				Args:  []interface{}{"value1", "value2", "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        "compound select query is nil",
+			SelectQuery: Union(Select(NewField("field1")).From(NewTable("table1")), nil),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSelectQueryIsRequired,
+			},
+		},
+		{
+			Name: "compound with fields",
+			SelectQuery: &SelectQuery{
+				Fields:    []*Field{NewField("field1")},
+				Compounds: []*CompoundQuery{NewCompoundQuery("", Select(NewField("field1")).From(NewTable("table1")))},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictCompoundsAndSelectClauses,
+			},
+		},
+		{
+			Name: "compound with unsupported set operator",
+			SelectQuery: &SelectQuery{
+				Compounds: []*CompoundQuery{
+					NewCompoundQuery("", Select(NewField("field1")).From(NewTable("table1"))),
+					NewCompoundQuery("minus", Select(NewField("field1")).From(NewTable("table2"))),
+				},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedSetOperatorf, "minus"),
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with quoted identifiers and exists filter on outer alias with bound value", DialectPostgres),
+			SelectQuery: Select(NewField("u.id")).
+				From(NewTable("users").As("u")).
+				Where(NewFilter().SetCondition(nil, OperatorExists, NewSelectQueryFilterValue(
+					Select(NewField("o.id")).
+						From(NewTable("orders").As("o")).
+						Where(NewFilter().SetCondition(NewField("u.status"), OperatorEqual, NewFilterValue("active"))),
+				))).
+				QuoteIdentifiers(),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select \"u\".\"id\" from \"users\" as \"u\" where exists (select \"o\".\"id\" from \"orders\" as \"o\" where \"u\".\"status\" = $1)",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with correlated exists filter", DialectPostgres),
+			SelectQuery: Select(NewField("u.id")).
+				From(NewTable("users").As("u")).
+				Where(NewFilter().SetCondition(nil, OperatorExists, NewSelectQueryFilterValue(
+					Select(NewField("o.id")).
+						From(NewTable("orders").As("o")).
+						Where(NewFilter().SetCondition(NewField("o.user_id"), OperatorEqual, NewFieldFilterValue(NewField("u.id")))),
+				))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.id from users as u where exists (select o.id from orders as o where o.user_id = u.id)",
+				Args:  nil,
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with quoted identifiers and correlated not exists filter", DialectMySQL),
+			SelectQuery: Select(NewField("u.id")).
+				From(NewTable("users").As("u")).
+				Where(NewFilter().SetCondition(nil, OperatorNotExists, NewSelectQueryFilterValue(
+					Select(NewField("o.id")).
+						From(NewTable("orders").As("o")).
+						Where(NewFilter().
+							SetLogic(LogicAnd).
+							AddFilter(NewField("o.user_id"), OperatorEqual, NewFieldFilterValue(NewField("u.id"))).
+							AddFilter(NewField("o.status"), OperatorEqual, NewFilterValue("paid"))),
+				))).
+				QuoteIdentifiers(),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select `u`.`id` from `users` as `u` where not exists (select `o`.`id` from `orders` as `o` where `o`.`user_id` = `u`.`id` and `o`.`status` = ?)",
+				Args:  []interface{}{"paid"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with aliased aggregate field reused in having", DialectPostgres),
+			SelectQuery: Select(NewField("user_id"), Sum(NewField("amount")).As("total")).
+				From(NewTable("orders")).
+				GroupBy(NewField("user_id")).
+				Having(NewFilter().SetCondition(Sum(NewField("amount")).As("total"), OperatorGreaterThan, NewFilterValue(100))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select user_id, sum(amount) as total from orders group by user_id having sum(amount) > $1",
+				Args:  []interface{}{100},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with page offset overflow", DialectPostgres),
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Page(math.MaxUint64, 10),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOffsetIsOverflow,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with page after page offset overflow", DialectPostgres),
+			SelectQuery: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Page(math.MaxUint64, 10).
+				Page(2, 10),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit $1 offset $2",
+				Args:  []interface{}{uint64(10), uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with schema and unsupported sort direction", DialectPostgres),
+			SelectQuery: Select(NewField("id")).
+				From(NewTable("t")).
+				OrderBy(NewSort("id", "desc; drop table t")).
+				WithSchema(NewSchema("t", "id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedSortDirectionf, "desc; drop table t"),
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union on sorted and limited receiver", DialectPostgres),
+			SelectQuery: Select(NewField("id")).
+				From(NewTable("a")).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(5).
+				Union(Select(NewField("id")).From(NewTable("b")).Limit(3)),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from a union select * from (select id from b limit $1) as compound_query order by id asc limit $2",
+				Args:  []interface{}{uint64(3), uint64(5)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union on limited branch", DialectMySQL),
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")),
+				Select(NewField("id")).From(NewTable("b")).OrderBy(NewSort("id", SortDirectionDescending)).Limit(2),
+			),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from a union select * from (select id from b order by id desc limit ?) as compound_query",
+				Args:  []interface{}{uint64(2)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union on limited branch", DialectSQLServer),
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")).Limit(2),
+				Select(NewField("id")).From(NewTable("b")),
+			).
+				QuoteIdentifiers(),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select * from (select [id] from [a] order by (select null) offset @p1 rows fetch next @p2 rows only) as [compound_query] union select [id] from [b]",
+				Args:  []interface{}{uint64(0), uint64(2)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with union on nested intersect", DialectSQLite),
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")),
+				Intersect(Select(NewField("id")).From(NewTable("b")), Select(NewField("id")).From(NewTable("c"))),
+			),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from a union select * from (select id from b intersect select id from c) as compound_query",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with common table expression on non first compound", DialectSQLServer),
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")),
+				Select(NewField("id")).From(NewTable("c")).With("c", Select(NewField("id")).From(NewTable("b")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")))),
+			),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with c as (select id from b where status = @p1) select id from a union select id from c",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with common table expression on nested compound", DialectPostgres),
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")).Where(NewFilter().SetCondition(NewField("id"), OperatorGreaterThan, NewFilterValue(1))),
+				Intersect(Select(NewField("id")).From(NewTable("b")), Select(NewField("id")).From(NewTable("d")).With("d", Select(NewField("id")).From(NewTable("c")).Where(NewFilter().SetCondition(NewField("id"), OperatorGreaterThan, NewFilterValue(2))))),
+			),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with d as (select id from c where id > $1) select id from a where id > $2 union select * from (select id from b intersect select id from d) as compound_query",
+				Args:  []interface{}{2, 1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
 	}
 }
 
@@ -723,6 +2626,31 @@ func TestSelectQuery_ToSQLWithArgsWithAlias(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:    "alias is not empty and quoted identifiers",
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Alias:             "alias1",
+				QuotedIdentifiers: true,
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `(select "field1" from "table1") as "alias1"`,
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -763,3 +2691,251 @@ func TestSelectQuery_ToSQLWithArgsWithAlias(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectQuery_ToCountSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:        "to sql with args is error",
+			Dialect:     DialectPostgres,
+			SelectQuery: &SelectQuery{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with sorts, take, skip and alias", DialectMySQL),
+			Dialect: DialectMySQL,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field:     "field1",
+						Direction: SortDirectionDescending,
+					},
+				},
+				Take:  10,
+				Skip:  20,
+				Alias: "alias1",
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select count(*) from (select field1 from table1 where field1 = ?) as count_query",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with group by", DialectPostgres),
+			Dialect: DialectPostgres,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field2",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value2",
+					},
+				},
+				Groups: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Take: 10,
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select count(*) from (select field1 from table1 where field2 = $1 group by field1) as count_query",
+				Args:  []interface{}{"value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with sorts, take and skip", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Filter: &Filter{
+					Field: &Field{
+						Column: "field1",
+					},
+					Operator: OperatorEqual,
+					Value: &FilterValue{
+						Value: "value1",
+					},
+				},
+				Sorts: []*Sort{
+					{
+						Field: "field1",
+					},
+				},
+				Take: 10,
+				Skip: 20,
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select count(*) from (select field1 from table1 where field1 = @p1) as count_query",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with common table expression", DialectPostgres),
+			Dialect: DialectPostgres,
+			SelectQuery: Select(NewField("field1")).
+				With("cte1", Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				From(NewTable("cte1")).
+				Where(NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue("value1"))).
+				OrderBy(NewSort("field1", SortDirectionAscending)).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table1 where field2 = $1) select count(*) from (select field1 from cte1 where field1 = $2) as count_query",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with union, sorts and take", DialectPostgres),
+			Dialect: DialectPostgres,
+			SelectQuery: Union(
+				Select(NewField("field1")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value1"))),
+				Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			).
+				OrderBy(NewSort("field1", SortDirectionAscending)).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select count(*) from (select field1 from table1 where field2 = $1 union select field1 from table2 where field2 = $2) as count_query",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with common table expression on non first compound", DialectSQLServer),
+			Dialect: DialectSQLServer,
+			SelectQuery: Union(
+				Select(NewField("id")).From(NewTable("a")),
+				Select(NewField("id")).From(NewTable("c")).With("c", Select(NewField("id")).From(NewTable("b")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")))),
+			),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with c as (select id from b where status = @p1) select count(*) from (select id from a union select id from c) as count_query",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToCountSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
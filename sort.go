@@ -2,11 +2,13 @@ package simple_query
 
 import (
 	"fmt"
+	"strings"
 )
 
 type Sort struct {
-	Field     string
-	Direction SortDirection
+	Field      string
+	Expression *Field
+	Direction  SortDirection
 }
 
 func NewSort(field string, direction SortDirection) *Sort {
@@ -16,18 +18,34 @@ func NewSort(field string, direction SortDirection) *Sort {
 	}
 }
 
+func NewExpressionSort(expression *Field, direction SortDirection) *Sort {
+	return &Sort{
+		Expression: expression,
+		Direction:  direction,
+	}
+}
+
 func (s *Sort) validate() error {
-	if s.Field == "" {
+	if s.Field == "" && s.Expression == nil {
 		return ErrFieldIsRequired
 	}
 
+	if s.Field != "" && s.Expression != nil {
+		return ErrConflictSortFieldAndSortExpression
+	}
+
+	if s.Direction != "" && s.Direction != SortDirectionAscending && s.Direction != SortDirectionDescending {
+		return fmt.Errorf(errUnsupportedSortDirectionf, s.Direction)
+	}
+
 	return nil
 }
 
-func (s *Sort) ToSQL() (string, error) {
+func (s *Sort) toSQL(dialect Dialect, options renderOptions) (string, error) {
 	var (
 		orderByQueryFormat string
 		orderByQuery       string
+		field              string
 		err                error
 	)
 
@@ -36,12 +54,95 @@ func (s *Sort) ToSQL() (string, error) {
 		return "", err
 	}
 
+	if s.Expression != nil {
+		return "", ErrArgsIsRequired
+	}
+
 	if s.Direction == "" {
 		s.Direction = SortDirectionAscending
 	}
 
 	orderByQueryFormat = "%s %s"
-	orderByQuery = fmt.Sprintf(orderByQueryFormat, s.Field, s.Direction)
+	field, err = options.identifier(dialect, s.Field)
+	if err != nil {
+		return "", err
+	}
+
+	orderByQuery = fmt.Sprintf(orderByQueryFormat, field, s.Direction)
 
 	return orderByQuery, nil
 }
+
+func (s *Sort) ToSQL() (string, error) {
+	return s.toSQL(nil, renderOptions{})
+}
+
+func (s *Sort) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		orderByQueryFormat string
+		orderByQuery       string
+		field              string
+		err                error
+	)
+
+	if dialect == nil {
+		return "", nil, ErrDialectIsRequired
+	}
+
+	if s.Expression == nil {
+		orderByQuery, err = s.toSQL(dialect, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return orderByQuery, args, nil
+	}
+
+	err = s.validate()
+	if err != nil {
+		return "", nil, err
+	}
+
+	field, args, err = s.Expression.toSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if s.Direction == "" {
+		s.Direction = SortDirectionAscending
+	}
+
+	orderByQueryFormat = "%s %s"
+	orderByQuery = fmt.Sprintf(orderByQueryFormat, field, s.Direction)
+
+	return orderByQuery, args, nil
+}
+
+func (s *Sort) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return s.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func sortsToSQLWithArgs(dialect Dialect, args []interface{}, sorts []*Sort, options renderOptions) (string, []interface{}, error) {
+	var (
+		orderBys []string
+		err      error
+	)
+
+	orderBys = []string{}
+	for i := range sorts {
+		var orderBy string
+
+		if sorts[i] == nil {
+			continue
+		}
+
+		orderBy, args, err = sorts[i].toSQLWithArgs(dialect, args, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		orderBys = append(orderBys, orderBy)
+	}
+
+	return strings.Join(orderBys, ", "), args, nil
+}
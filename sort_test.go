@@ -1,6 +1,7 @@
 package simple_query
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -21,6 +22,10 @@ func testSort_SortEquality(t *testing.T, expectation, actual *Sort) {
 		t.Errorf("expectation field is %s, got %s", expectation.Field, actual.Field)
 	}
 
+	if !deepEqual(expectation.Expression, actual.Expression) {
+		t.Errorf("expectation expression is %+v, got %+v", expectation.Expression, actual.Expression)
+	}
+
 	if expectation.Direction != actual.Direction {
 		t.Errorf("expectation direction is %s, got %s", expectation.Direction, actual.Direction)
 	}
@@ -42,6 +47,24 @@ func TestSort_NewSort(t *testing.T) {
 	testSort_SortEquality(t, expectation, actual)
 }
 
+func TestSort_NewExpressionSort(t *testing.T) {
+	var (
+		expectation *Sort
+		actual      *Sort
+	)
+
+	expectation = &Sort{
+		Expression: &Field{
+			Function: "count",
+		},
+		Direction: SortDirectionDescending,
+	}
+
+	actual = NewExpressionSort(Count(), SortDirectionDescending)
+
+	testSort_SortEquality(t, expectation, actual)
+}
+
 func TestSort_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -57,6 +80,22 @@ func TestSort_validate(t *testing.T) {
 			Sort:        &Sort{},
 			Expectation: ErrFieldIsRequired,
 		},
+		{
+			Name: "field is not empty and expression is not nil",
+			Sort: &Sort{
+				Field:      "field1",
+				Expression: &Field{},
+			},
+			Expectation: ErrConflictSortFieldAndSortExpression,
+		},
+		{
+			Name: "direction is unsupported",
+			Sort: &Sort{
+				Field:     "field1",
+				Direction: "desc; drop table table1",
+			},
+			Expectation: fmt.Errorf(errUnsupportedSortDirectionf, "desc; drop table table1"),
+		},
 		{
 			Name: "sort is valid",
 			Sort: &Sort{
@@ -113,6 +152,21 @@ func TestSort_ToSQL(t *testing.T) {
 				Err:   ErrFieldIsRequired,
 			},
 		},
+		{
+			Name: "expression is not nil",
+			Sort: &Sort{
+				Expression: &Field{
+					Function: "count",
+				},
+			},
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrArgsIsRequired,
+			},
+		},
 		{
 			Name: "default direction",
 			Sort: &Sort{
@@ -169,3 +223,185 @@ func TestSort_ToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestSort_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Sort        *Sort
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Sort        *Sort
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "dialect is empty",
+			Sort:    &Sort{},
+			Dialect: nil,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:    "field is empty",
+			Sort:    &Sort{},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name: "field is not empty",
+			Sort: &Sort{
+				Field:     "field1",
+				Direction: SortDirectionDescending,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "field is not empty and expression is not nil",
+			Sort: &Sort{
+				Field:      "field1",
+				Expression: &Field{},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictSortFieldAndSortExpression,
+			},
+		},
+		{
+			Name: "expression to sql with args is error",
+			Sort: &Sort{
+				Expression: &Field{},
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name: "expression with default direction",
+			Sort: &Sort{
+				Expression: &Field{
+					Function: "coalesce",
+					FunctionArgs: []interface{}{
+						&Field{
+							Column: "field1",
+						},
+						"value1",
+					},
+				},
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "coalesce(field1, ?) asc",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "expression is not nil and direction is unsupported",
+			Sort: &Sort{
+				Expression: &Field{
+					Column: "field1",
+				},
+				Direction: "up",
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedSortDirectionf, "up"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Sort.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %+v, got %+v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
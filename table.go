@@ -26,7 +26,7 @@ func (t *Table) As(alias string) *Table {
 }
 
 func (t *Table) validate(dialect Dialect) error {
-	if dialect == "" {
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
@@ -45,7 +45,7 @@ func (t *Table) validate(dialect Dialect) error {
 	return nil
 }
 
-func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (t *Table) toSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		table string
 		err   error
@@ -56,9 +56,13 @@ func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 		return "", nil, err
 	}
 
-	table = t.Name
+	table, err = options.identifier(dialect, t.Name)
+	if err != nil {
+		return "", nil, err
+	}
+
 	if t.SelectQuery != nil {
-		table, args, err = t.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+		table, args, err = t.SelectQuery.toSQLWithArgsWithAlias(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -69,20 +73,35 @@ func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 	return table, args, nil
 }
 
-func (t *Table) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return t.toSQLWithArgs(dialect, args, renderOptions{})
+}
+
+func (t *Table) toSQLWithArgsWithAlias(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
 	var (
 		table string
 		err   error
 	)
 
-	table, args, err = t.ToSQLWithArgs(dialect, args)
+	table, args, err = t.toSQLWithArgs(dialect, args, options)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if t.Alias != "" {
-		table = fmt.Sprintf("%s as %s", table, t.Alias)
+		var alias string
+
+		alias, err = options.alias(dialect, t.Alias)
+		if err != nil {
+			return "", nil, err
+		}
+
+		table = fmt.Sprintf("%s as %s", table, alias)
 	}
 
 	return table, args, nil
 }
+
+func (t *Table) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return t.toSQLWithArgsWithAlias(dialect, args, renderOptions{})
+}
@@ -89,7 +89,7 @@ func TestTable_validate(t *testing.T) {
 		{
 			Name:        "dialect is empty",
 			Table:       &Table{},
-			Dialect:     "",
+			Dialect:     nil,
 			Expectation: ErrDialectIsRequired,
 		},
 		{
@@ -6,9 +6,18 @@ import (
 )
 
 type UpdateQuery struct {
-	Table       string
-	FieldsValue map[string]interface{}
-	Filter      *Filter
+	CommonTableExpressions []*CommonTableExpression
+	Table                  string
+	Fields                 []string
+	FieldsValue            map[string]interface{}
+	Joins                  []*Join
+	Filter                 *Filter
+	Sorts                  []*Sort
+	Take                   uint64
+	ReturningFields        []*Field
+
+	QuotedIdentifiers bool
+	Schema            *Schema
 }
 
 func Update(table string) *UpdateQuery {
@@ -18,18 +27,82 @@ func Update(table string) *UpdateQuery {
 	}
 }
 
+func (u *UpdateQuery) With(name string, selectQuery *SelectQuery) *UpdateQuery {
+	u.CommonTableExpressions = append(u.CommonTableExpressions, NewCommonTableExpression(name, selectQuery))
+	return u
+}
+
+func (u *UpdateQuery) WithRecursive(name string, anchor, recursive *SelectQuery) *UpdateQuery {
+	u.CommonTableExpressions = append(u.CommonTableExpressions, NewRecursiveCommonTableExpression(name, anchor, recursive))
+	return u
+}
+
 func (u *UpdateQuery) Set(field string, value interface{}) *UpdateQuery {
+	if _, ok := u.FieldsValue[field]; !ok {
+		u.Fields = append(u.Fields, field)
+	}
+
 	u.FieldsValue[field] = value
 	return u
 }
 
+func (u *UpdateQuery) Join(table *Table, filter *Filter) *UpdateQuery {
+	u.Joins = append(u.Joins, NewJoin(JoinTypeInner, table, filter))
+	return u
+}
+
+func (u *UpdateQuery) LeftJoin(table *Table, filter *Filter) *UpdateQuery {
+	u.Joins = append(u.Joins, NewJoin(JoinTypeLeft, table, filter))
+	return u
+}
+
 func (u *UpdateQuery) Where(filter *Filter) *UpdateQuery {
 	u.Filter = filter
 	return u
 }
 
+func (u *UpdateQuery) OrderBy(sorts ...*Sort) *UpdateQuery {
+	u.Sorts = sorts
+	return u
+}
+
+func (u *UpdateQuery) Limit(take uint64) *UpdateQuery {
+	u.Take = take
+	return u
+}
+
+func (u *UpdateQuery) Returning(fields ...*Field) *UpdateQuery {
+	u.ReturningFields = fields
+	return u
+}
+
+func (u *UpdateQuery) QuoteIdentifiers() *UpdateQuery {
+	u.QuotedIdentifiers = true
+	return u
+}
+
+func (u *UpdateQuery) WithSchema(schema *Schema) *UpdateQuery {
+	u.Schema = schema
+	return u
+}
+
+func (u *UpdateQuery) getColumns() []string {
+	var columns []string = []string{}
+
+	for field := range u.FieldsValue {
+		columns = append(columns, field)
+	}
+
+	return orderedColumns(u.Fields, columns)
+}
+
 func (u *UpdateQuery) validate(dialect Dialect) error {
-	if dialect == "" {
+	var (
+		joinStyle UpdateJoinStyle
+		err       error
+	)
+
+	if dialect == nil {
 		return ErrDialectIsRequired
 	}
 
@@ -51,16 +124,70 @@ func (u *UpdateQuery) validate(dialect Dialect) error {
 		return ErrFilterIsRequired
 	}
 
-	return nil
+	joinStyle = updateJoinStyle(dialect)
+	if len(u.Joins) > 0 && joinStyle != UpdateJoinStyleJoin && joinStyle != UpdateJoinStyleFrom && joinStyle != UpdateJoinStyleFromTarget {
+		return fmt.Errorf(errUnsupportedJoinForDialectf, dialect.Name())
+	}
+
+	for i := range u.Joins {
+		if u.Joins[i] == nil {
+			return ErrJoinIsNil
+		}
+	}
+
+	err = validateOrderByLimit(dialect, u.Joins, u.Sorts, u.Take)
+	if err != nil {
+		return err
+	}
+
+	return validateReturning(dialect, u.ReturningFields)
+}
+
+func (u *UpdateQuery) setToSQLWithArgs(dialect Dialect, args []interface{}, options renderOptions) (string, []interface{}, error) {
+	var (
+		columns []string
+		sets    []string
+		err     error
+	)
+
+	columns = u.getColumns()
+	sets = []string{}
+
+	for i := range columns {
+		var (
+			column string
+			value  string
+		)
+
+		column, err = options.identifier(dialect, columns[i])
+		if err != nil {
+			return "", nil, err
+		}
+
+		value, args, err = setValueToSQLWithArgs(dialect, args, u.FieldsValue[columns[i]], options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		sets = append(sets, fmt.Sprintf("%s = %s", column, value))
+	}
+
+	return strings.Join(sets, ", "), args, nil
 }
 
 func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
 	var (
-		query        string
-		args         []interface{}
-		placeholders []string
-		whereClause  string
-		err          error
+		query       string
+		args        []interface{}
+		withClause  string
+		sets        string
+		joinClause  string
+		filter      *Filter
+		whereClause string
+		table       string
+		joinStyle   UpdateJoinStyle
+		options     renderOptions
+		err         error
 	)
 
 	err = u.validate(dialect)
@@ -68,27 +195,68 @@ func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("update %s", u.Table)
-	placeholders = []string{}
+	options = renderOptions{quoteIdentifiers: u.QuotedIdentifiers, schema: u.Schema}
+	table, err = options.identifier(dialect, u.Table)
+	if err != nil {
+		return "", nil, err
+	}
 
-	for field, value := range u.FieldsValue {
-		var (
-			placeholderStartIdx int
-			placeholderEndIdx   int
-			placeholder         string
-		)
+	withClause, args, err = commonTableExpressionsToSQLWithArgs(dialect, args, u.CommonTableExpressions, options)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("update %s", table)
+	if withClause != "" {
+		query = fmt.Sprintf("%s %s", withClause, query)
+	}
+
+	filter = u.Filter
+	joinStyle = updateJoinStyle(dialect)
+
+	if len(u.Joins) > 0 && joinStyle == UpdateJoinStyleJoin {
+		joinClause, args, err = joinsToSQLWithArgs(dialect, args, u.Joins, options)
+		if err != nil {
+			return "", nil, err
+		}
 
-		args = append(args, value)
-		placeholderStartIdx = len(args)
-		placeholderEndIdx = len(args)
-		placeholder = fmt.Sprintf("%s = %s", field, getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx))
-		placeholders = append(placeholders, placeholder)
+		query = fmt.Sprintf("%s %s", query, joinClause)
 	}
 
-	query = fmt.Sprintf("%s set %s", query, strings.Join(placeholders, ", "))
+	sets, args, err = u.setToSQLWithArgs(dialect, args, options)
+	if err != nil {
+		return "", nil, err
+	}
 
-	if u.Filter != nil {
-		whereClause, args, err = u.Filter.ToSQLWithArgs(dialect, args)
+	query = fmt.Sprintf("%s set %s", query, sets)
+
+	if len(u.Joins) > 0 && joinStyle == UpdateJoinStyleFrom {
+		var joinFilter *Filter
+
+		joinClause, joinFilter, args, err = joinsToFromSQLWithArgs(dialect, args, u.Joins, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s from %s", query, joinClause)
+		filter = combineFilters(joinFilter, u.Filter)
+	}
+
+	if len(u.Joins) > 0 && joinStyle == UpdateJoinStyleFromTarget {
+		joinClause, args, err = joinsToSQLWithArgs(dialect, args, u.Joins, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s from %s %s", query, table, joinClause)
+	}
+
+	if (len(u.Sorts) > 0 || u.Take > 0) && orderByLimitStyle(dialect) == OrderByLimitStyleCTID {
+		filter = orderByLimitFilter(u.Table, filter, u.Sorts, u.Take)
+	}
+
+	if filter != nil {
+		whereClause, args, err = filter.toSQLWithArgsWithOptions(dialect, args, options)
 		if err != nil {
 			return "", nil, err
 		}
@@ -98,5 +266,29 @@ func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		}
 	}
 
+	if (len(u.Sorts) > 0 || u.Take > 0) && orderByLimitStyle(dialect) == OrderByLimitStyleClause {
+		var orderByLimitClause string
+
+		orderByLimitClause, args, err = orderByLimitToSQLWithArgs(dialect, args, u.Sorts, u.Take, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if orderByLimitClause != "" {
+			query = fmt.Sprintf("%s %s", query, orderByLimitClause)
+		}
+	}
+
+	if len(u.ReturningFields) > 0 {
+		var returningClause string
+
+		returningClause, args, err = returningToSQLWithArgs(dialect, args, u.ReturningFields, options)
+		if err != nil {
+			return "", nil, err
+		}
+
+		query = fmt.Sprintf("%s %s", query, returningClause)
+	}
+
 	return query, args, nil
 }
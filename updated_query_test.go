@@ -22,6 +22,16 @@ func testUpdateQuery_UpdateQueryEquality(t *testing.T, expectation, actual *Upda
 		t.Errorf("expected table is %s, got %s", expectation.Table, actual.Table)
 	}
 
+	if len(expectation.Fields) != len(actual.Fields) {
+		t.Errorf("expected length of fields is %d, got %d", len(expectation.Fields), len(actual.Fields))
+	}
+
+	for i := range expectation.Fields {
+		if i < len(actual.Fields) && expectation.Fields[i] != actual.Fields[i] {
+			t.Errorf("expected field is %s, got %s", expectation.Fields[i], actual.Fields[i])
+		}
+	}
+
 	if len(expectation.FieldsValue) != len(actual.FieldsValue) {
 		t.Errorf("expected length of fields value is %d, got %d", len(expectation.FieldsValue), len(actual.FieldsValue))
 	}
@@ -61,16 +71,18 @@ func TestUpdateQuery_Set(t *testing.T) {
 	)
 
 	expectation = &UpdateQuery{
-		Table: "table1",
+		Table:  "table1",
+		Fields: []string{"field2", "field1"},
 		FieldsValue: map[string]interface{}{
-			"field1": "value1",
+			"field1": "value3",
 			"field2": 2,
 		},
 	}
 
 	actual = Update("table1").
+		Set("field2", 2).
 		Set("field1", "value1").
-		Set("field2", 2)
+		Set("field1", "value3")
 
 	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
 }
@@ -82,7 +94,8 @@ func TestUpdateQuery_Where(t *testing.T) {
 	)
 
 	expectation = &UpdateQuery{
-		Table: "table1",
+		Table:  "table1",
+		Fields: []string{"field1", "field2"},
 		FieldsValue: map[string]interface{}{
 			"field1": "value1",
 			"field2": 2,
@@ -115,6 +128,24 @@ func TestUpdateQuery_Where(t *testing.T) {
 	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
 }
 
+func TestUpdateQuery_QuoteIdentifiers(t *testing.T) {
+	var actual *UpdateQuery = Update("table1").
+		QuoteIdentifiers()
+
+	if !actual.QuotedIdentifiers {
+		t.Error("expectation quoted identifiers is true, got false")
+	}
+}
+
+func TestUpdateQuery_WithSchema(t *testing.T) {
+	var actual *UpdateQuery = Update("table1").
+		WithSchema(NewSchema("table1"))
+
+	if actual.Schema == nil {
+		t.Error("expectation schema is not nil, got nil")
+	}
+}
+
 func TestUpdateQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -129,7 +160,7 @@ func TestUpdateQuery_validate(t *testing.T) {
 	}{
 		{
 			Name:        "dialect is empty",
-			Dialect:     "",
+			Dialect:     nil,
 			UpdateQuery: &UpdateQuery{},
 			Expectation: ErrDialectIsRequired,
 		},
@@ -270,6 +301,335 @@ func TestUpdateQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   ErrFiltersIsRequired,
 			},
 		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and returning", DialectSQLite),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				Returning(NewField("id"), NewField("field1").As("alias1")),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ? where field2 = ? returning id, field1 as alias1",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and returning", DialectMySQL),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				Returning(NewField("id")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedReturningForDialectf, DialectMySQL.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and joins", DialectMySQL),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 inner join table2 as t2 on t2.field2 = ? left join table3 as t3 on t3.field3 = ? set field1 = ? where table1.field4 = ?",
+				Args:  []interface{}{"value2", "value3", "value1", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and joins", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = $1 from table2 as t2 left join table3 as t3 on t3.field3 = $2 where t2.field2 = $3 and table1.field4 = $4",
+				Args:  []interface{}{"value1", "value3", "value2", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and joins", DialectSQLite),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ? from table2 as t2 left join table3 as t3 on t3.field3 = ? where t2.field2 = ? and table1.field4 = ?",
+				Args:  []interface{}{"value1", "value3", "value2", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and joins", DialectSQLServer),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = @p1 from table1 inner join table2 as t2 on t2.field2 = @p2 left join table3 as t3 on t3.field3 = @p3 where table1.field4 = @p4",
+				Args:  []interface{}{"value1", "value2", "value3", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and first join is left join", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				LeftJoin(NewTable("table2"), NewFilter().SetCondition(NewField("table2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				Where(NewFilter().SetCondition(NewField("table1.field3"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedJoinTypeForDialectf, JoinTypeLeft, DialectPostgres.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and join is nil", DialectMySQL),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field1": "value1",
+				},
+				Joins:  []*Join{nil},
+				Filter: NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")),
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinIsNil,
+			},
+		},
+		{
+			Name: "update with custom dialect and joins",
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				LeftJoin(NewTable("table3").As("t3"), NewFilter().SetCondition(NewField("t3.field3"), OperatorEqual, NewFilterValue("value3"))).
+				Where(NewFilter().SetCondition(NewField("table1.field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: oracleDialect{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = :1 from table2 as t2 left join table3 as t3 on t3.field3 = :2 where t2.field2 = :3 and table1.field4 = :4",
+				Args:  []interface{}{"value1", "value3", "value2", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "update with dialect without update join capability and joins",
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Join(NewTable("table2").As("t2"), NewFilter().SetCondition(NewField("t2.field2"), OperatorEqual, NewFilterValue("value2"))).
+				Where(NewFilter().SetCondition(NewField("table1.field3"), OperatorEqual, NewFilterValue("value3"))),
+			Dialect: wrappedDialect{DialectPostgres},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedJoinForDialectf, DialectPostgres.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and order by and limit", DialectMySQL),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ? where field2 = ? order by id asc limit ?",
+				Args:  []interface{}{"value1", "value2", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and order by and limit", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = $1 where ctid in (select ctid from table1 where field2 = $2 order by id asc limit $3)",
+				Args:  []interface{}{"value1", "value2", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s, quoted identifiers, schema and order by and limit", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000).
+				QuoteIdentifiers().
+				WithSchema(NewSchema("table1", "field1", "field2", "id")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `update "table1" set "field1" = $1 where "ctid" in (select "ctid" from "table1" where "field2" = $2 order by "id" asc limit $3)`,
+				Args:  []interface{}{"value1", "value2", uint64(1000)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and order by and limit", DialectSQLServer),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))).
+				OrderBy(NewSort("id", SortDirectionAscending)).
+				Limit(1000),
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errUnsupportedOrderByLimitForDialectf, DialectSQLServer.Name()),
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and expression values", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", "value1").
+				Set("views", Add(NewField("views"), 1)).
+				Set("updated_at", NewFuncField("now")).
+				Set("field2", NewField("field3")).
+				Set("field4", Select(Max(NewField("field4"))).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field5"), OperatorEqual, NewFilterValue("value5")))).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = $1, views = views + $2, updated_at = now(), field2 = field3, field4 = (select max(field4) from table2 where field5 = $3) where id = $4",
+				Args:  []interface{}{"value1", 1, "value5", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and invalid expression value", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("views", NewExpression(NewField("views"), "", 1)).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOperatorIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with fields in set order", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field3", "value3").
+				Set("field1", "value1").
+				Set("field2", "value2").
+				Where(NewFilter().SetCondition(NewField("field4"), OperatorEqual, NewFilterValue("value4"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field3 = $1, field1 = $2, field2 = $3 where field4 = $4",
+				Args:  []interface{}{"value3", "value1", "value2", "value4"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s without fields order", DialectPostgres),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field3": "value3",
+					"field1": "value1",
+					"field2": "value2",
+				},
+				Filter: NewFilter().SetCondition(NewField("field4"), OperatorEqual, NewFilterValue("value4")),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = $1, field2 = $2, field3 = $3 where field4 = $4",
+				Args:  []interface{}{"value1", "value2", "value3", "value4"},
+				Err:   nil,
+			},
+		},
 		{
 			Name: fmt.Sprintf("update with dialect %s with filter", DialectPostgres),
 			UpdateQuery: &UpdateQuery{
@@ -299,6 +659,189 @@ func TestUpdateQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with filter and quoted identifiers", DialectPostgres),
+			UpdateQuery: &UpdateQuery{
+				Table: "user",
+				FieldsValue: map[string]interface{}{
+					"UserName": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("Id"),
+					Operator: OperatorEqual,
+					Value:    NewFilterValue(1),
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `update "user" set "UserName" = $1 where "Id" = $2`,
+				Args:  []interface{}{"value1", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and schema and table is not allowed", DialectPostgres),
+			UpdateQuery: &UpdateQuery{
+				Table: "table2",
+				FieldsValue: map[string]interface{}{
+					"field1": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("field1"),
+					Operator: OperatorEqual,
+					Value:    NewFilterValue(1),
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "table2", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and schema and column is not allowed", DialectPostgres),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field2": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("field1"),
+					Operator: OperatorEqual,
+					Value:    NewFilterValue(1),
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field2", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and schema and filter column is not allowed", DialectPostgres),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field1": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("field2"),
+					Operator: OperatorEqual,
+					Value:    NewFilterValue(1),
+				},
+				Schema: NewSchema("table1", "field1"),
+			},
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &IdentifierError{Identifier: "field2", Err: ErrIdentifierIsNotAllowed},
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with filter", DialectSQLite),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field1": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("field2"),
+					Operator: OperatorNotLike,
+					Value:    NewFilterValue("value2"),
+				},
+			},
+			Dialect: DialectSQLite,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ? where lower(field2) not like lower('%' || ? || '%')",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with filter", DialectSQLServer),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field1": "value1",
+				},
+				Filter: &Filter{
+					Field:    NewField("field2"),
+					Operator: OperatorLike,
+					Value:    NewFilterValue("value2"),
+				},
+				QuotedIdentifiers: true,
+			},
+			Dialect: DialectSQLServer,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update [table1] set [field1] = @p1 where [field2] like concat('%', @p2, '%')",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and common table expression", DialectPostgres),
+			UpdateQuery: Update("table1").
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field3"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("cte1"))))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = $1) update table1 set field1 = $2 where field3 in (select field1 from cte1)",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and common table expression", DialectMySQL),
+			UpdateQuery: Update("table1").
+				With("cte1", Select(NewField("field1")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2")))).
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("field3"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("field1")).From(NewTable("cte1"))))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with cte1 as (select field1 from table2 where field2 = ?) update table1 set field1 = ? where field3 in (select field1 from cte1)",
+				Args:  []interface{}{"value2", "value1"},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := 0; i < len(testCases); i++ {